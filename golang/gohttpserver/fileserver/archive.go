@@ -0,0 +1,325 @@
+package fileserver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Archive is a zip or tar file opened as a tree to serve with WithFS.
+type Archive struct {
+	fs.FS
+	f *os.File
+}
+
+// OpenArchive opens the .zip, .tar, .tar.gz or .tgz file name. Gzipped
+// tarballs are decompressed into memory, the others are read in place.
+func OpenArchive(name string) (*Archive, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	finfo, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	var fsys fs.FS
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		fsys, err = ZipFS(f, finfo.Size())
+	case strings.HasSuffix(lower, ".tar"):
+		fsys, err = TarFS(f, finfo.Size())
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		var zr *gzip.Reader
+		if zr, err = gzip.NewReader(f); err == nil {
+			var data []byte
+			if data, err = io.ReadAll(zr); err == nil {
+				fsys, err = TarFS(bytes.NewReader(data), int64(len(data)))
+			}
+		}
+	default:
+		err = errors.New("unknown archive type")
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return &Archive{fsys, f}, nil
+}
+
+// Close closes the archive file.
+func (a *Archive) Close() error {
+	return a.f.Close()
+}
+
+// ZipFS reads the zip archive in r. Stored, uncompressed entries can be
+// seeked into, so range requests on them work as on plain files.
+func ZipFS(r io.ReaderAt, size int64) (fs.FS, error) {
+	return newZipFS(r, size)
+}
+
+func newZipFS(r io.ReaderAt, size int64) (*zipFS, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil && err != zip.ErrInsecurePath {
+		return nil, err
+	}
+	z := &zipFS{Reader: zr, r: r, stored: map[string]*zip.File{}}
+	for _, f := range zr.File {
+		if f.Method == zip.Store && f.Flags&0x1 == 0 && fs.ValidPath(f.Name) && !strings.HasSuffix(f.Name, "/") {
+			z.stored[f.Name] = f
+		}
+	}
+	return z, nil
+}
+
+type zipFS struct {
+	*zip.Reader
+	r      io.ReaderAt
+	stored map[string]*zip.File
+}
+
+func (z *zipFS) Open(name string) (fs.File, error) {
+	if f, ok := z.stored[name]; ok {
+		if off, err := f.DataOffset(); err == nil {
+			return &sectionFile{io.NewSectionReader(z.r, off, int64(f.UncompressedSize64)), f.FileInfo()}, nil
+		}
+	}
+	return z.Reader.Open(name)
+}
+
+// TarFS reads the uncompressed tarball in r. Directories missing from it
+// are made up from the paths of their files; links, devices and entries
+// with invalid names are left out.
+func TarFS(r io.ReaderAt, size int64) (fs.FS, error) {
+	sr := io.NewSectionReader(r, 0, size)
+	t, err := readTar(tar.NewReader(sr), func() (int64, error) {
+		return sr.Seek(0, io.SeekCurrent)
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	t.r = r
+	return t, nil
+}
+
+// errTooManyEntries stops reading archives with more entries than allowed.
+var errTooManyEntries = errors.New("too many archive entries")
+
+// readTar indexes the members of tr, with the offsets of their contents
+// if offset is given. maxEntries, unless 0, limits the number of members.
+func readTar(tr *tar.Reader, offset func() (int64, error), maxEntries int) (*tarFS, error) {
+	t := &tarFS{entries: map[string]*tarEntry{
+		".": {info: dirInfo(".")},
+	}}
+	for member := 0; ; member++ {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if maxEntries > 0 && member >= maxEntries {
+			return nil, errTooManyEntries
+		}
+		if err != nil && err != tar.ErrInsecurePath {
+			return nil, err
+		}
+		name := strings.TrimPrefix(path.Clean("/"+hdr.Name), "/")
+		if name == "" || !fs.ValidPath(name) {
+			continue
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			t.dir(name).info = hdr.FileInfo()
+		case tar.TypeReg, tar.TypeRegA:
+			e := &tarEntry{info: hdr.FileInfo(), member: member}
+			if offset != nil {
+				if e.off, err = offset(); err != nil {
+					return nil, err
+				}
+			}
+			if old, ok := t.entries[name]; !ok {
+				// later members replace earlier ones, as when extracting
+				parent := t.dir(path.Dir(name))
+				parent.children = append(parent.children, e)
+				t.entries[name] = e
+			} else if !old.info.IsDir() {
+				*old = *e
+			}
+		}
+	}
+	for _, e := range t.entries {
+		sort.Slice(e.children, func(i, j int) bool {
+			return e.children[i].info.Name() < e.children[j].info.Name()
+		})
+	}
+	return t, nil
+}
+
+// tarFS is a tarball read in place from r, or else read again from the
+// start by stream to get at a member.
+type tarFS struct {
+	r       io.ReaderAt
+	stream  func() (*tar.Reader, io.Closer, error)
+	entries map[string]*tarEntry
+}
+
+// tarEntry is a file of a tarball, with its position among the members
+// and the offset of its contents, or a directory with its sorted children.
+type tarEntry struct {
+	info     fs.FileInfo
+	member   int
+	off      int64
+	children []*tarEntry
+}
+
+// dir returns the directory entry name, making it and its parents up if
+// the tarball has not listed them yet.
+func (t *tarFS) dir(name string) *tarEntry {
+	if e, ok := t.entries[name]; ok {
+		return e
+	}
+	e := &tarEntry{info: dirInfo(path.Base(name))}
+	t.entries[name] = e
+	parent := t.dir(path.Dir(name))
+	parent.children = append(parent.children, e)
+	return e
+}
+
+func (t *tarFS) lookup(op, name string) (*tarEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	e, ok := t.entries[name]
+	if !ok {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+	return e, nil
+}
+
+func (t *tarFS) Open(name string) (fs.File, error) {
+	e, err := t.lookup("open", name)
+	if err != nil {
+		return nil, err
+	}
+	if e.info.IsDir() {
+		return &dirFile{info: e.info, entries: e.dirEntries()}, nil
+	}
+	if t.r == nil {
+		return t.openStream(name, e)
+	}
+	return &sectionFile{io.NewSectionReader(t.r, e.off, e.info.Size()), e.info}, nil
+}
+
+// openStream reads the tarball up to the member e.
+func (t *tarFS) openStream(name string, e *tarEntry) (fs.File, error) {
+	tr, c, err := t.stream()
+	if err != nil {
+		return nil, err
+	}
+	for member := 0; member <= e.member; member++ {
+		if _, err = tr.Next(); err != nil && err != tar.ErrInsecurePath {
+			c.Close()
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+		}
+	}
+	return &streamFile{io.LimitReader(tr, e.info.Size()), c, e.info}, nil
+}
+
+func (t *tarFS) Stat(name string) (fs.FileInfo, error) {
+	e, err := t.lookup("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return e.info, nil
+}
+
+func (t *tarFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	e, err := t.lookup("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	if !e.info.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+	return e.dirEntries(), nil
+}
+
+func (e *tarEntry) dirEntries() []fs.DirEntry {
+	list := make([]fs.DirEntry, len(e.children))
+	for i, c := range e.children {
+		list[i] = fs.FileInfoToDirEntry(c.info)
+	}
+	return list
+}
+
+// sectionFile is an archive member that can be read at any offset.
+type sectionFile struct {
+	*io.SectionReader
+	info fs.FileInfo
+}
+
+func (f *sectionFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *sectionFile) Close() error               { return nil }
+
+// streamFile is an archive member that can only be read through.
+type streamFile struct {
+	io.Reader
+	io.Closer
+	info fs.FileInfo
+}
+
+func (f *streamFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+// dirFile is an open directory of an archive or an overlay.
+type dirFile struct {
+	info    fs.FileInfo
+	entries []fs.DirEntry
+}
+
+func (d *dirFile) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *dirFile) Close() error               { return nil }
+
+func (d *dirFile) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.Name(), Err: errors.New("is a directory")}
+}
+
+func (d *dirFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		list := d.entries
+		d.entries = nil
+		return list, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(d.entries) {
+		n = len(d.entries)
+	}
+	list := d.entries[:n]
+	d.entries = d.entries[n:]
+	return list, nil
+}
+
+// dirInfo describes a directory an archive does not list itself.
+type dirInfo string
+
+func (d dirInfo) Name() string       { return string(d) }
+func (d dirInfo) Size() int64        { return 0 }
+func (d dirInfo) Mode() fs.FileMode  { return fs.ModeDir | 0555 }
+func (d dirInfo) ModTime() time.Time { return time.Time{} }
+func (d dirInfo) IsDir() bool        { return true }
+func (d dirInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,185 @@
+package fileserver
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+var moreMarker = []byte("<!--more-->")
+
+// renderExcerpt renders the source of name up to <!--more-->, or the first
+// paragraph that is not a heading when there is no marker.
+func (s *Server) renderExcerpt(name string, body []byte) (string, error) {
+	src := body
+	if i := bytes.Index(body, moreMarker); i >= 0 {
+		src = body[:i]
+	} else {
+		src = nil
+		for _, para := range bytes.Split(bytes.Replace(body, []byte("\r\n"), []byte("\n"), -1), []byte("\n\n")) {
+			para = bytes.TrimSpace(para)
+			if len(para) != 0 && para[0] != '#' {
+				src = para
+				break
+			}
+		}
+	}
+	if r, ok := s.renderers[strings.ToLower(path.Ext(name))]; ok {
+		out, err := r(src)
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	}
+	out := &bytes.Buffer{}
+	if err := s.md.Convert(src, out); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func feedLinks(link string) string {
+	return fmt.Sprintf(`<a href="%s?feed=atom" title="Atom"><i class="fa fa-rss-square raw"></i></a>`+
+		`<a href="%s?feed=rss" title="RSS 2.0"><i class="fa fa-rss raw"></i></a>`, link, link)
+}
+
+func feedHead(link string) string {
+	return fmt.Sprintf(`<link rel="alternate" type="application/atom+xml" href="%s?feed=atom"/>`+"\n"+
+		`<link rel="alternate" type="application/rss+xml" href="%s?feed=rss"/>`, link, link)
+}
+
+func (s *Server) blogPosts(dir string) []*article {
+	s.blogs.Lock()
+	defer s.blogs.Unlock()
+	if posts, ok := s.blogs.m[dir]; ok {
+		return posts
+	}
+	var posts []*article
+	s.walkPages(dir, func(name string, finfo os.FileInfo) {
+		a, err := s.readArticle(name, finfo)
+		if err != nil {
+			log.Printf("%s: %v", name, err)
+		}
+		if a != nil && !a.meta.draft() {
+			posts = append(posts, a)
+		}
+	})
+	sortArticles(posts)
+	s.blogs.m[dir] = posts
+	return posts
+}
+
+func (s *Server) invalidateBlogs(name string) {
+	s.blogs.Lock()
+	defer s.blogs.Unlock()
+	if base := path.Base(name); base == ignoreName || base == ".hidden" {
+		s.blogs.m = map[string][]*article{}
+		return
+	}
+	for dir := range s.blogs.m {
+		if name == dir || dir == "." || strings.HasPrefix(name, dir+"/") {
+			delete(s.blogs.m, dir)
+		}
+	}
+}
+
+// blogHandler serves a blog directory: a paginated index of its posts, or
+// its feed when asked for with ?feed=rss or ?feed=atom.
+func (s *Server) blogHandler(rw http.ResponseWriter, req *http.Request, dir string, cfg *blogConfig) {
+	posts := s.blogPosts(dir)
+	link := req.URL.EscapedPath()
+	if !strings.HasSuffix(link, "/") {
+		link += "/"
+	}
+	title := cfg.Title
+	if title == "" {
+		title = path.Base(dir)
+		if dir == "." {
+			title = req.Host
+		}
+	}
+	if kind := req.FormValue("feed"); kind != "" {
+		s.writeFeed(rw, req, kind, title, cfg.Description, link, posts)
+		return
+	}
+
+	size := cfg.PageSize
+	if size <= 0 {
+		size = 10
+	}
+	pages := (len(posts) + size - 1) / size
+	page := 1
+	if p := req.FormValue("page"); p != "" {
+		page, _ = strconv.Atoi(p)
+		if page < 1 || page > pages {
+			s.httpError(rw, req, http.StatusNotFound, nil)
+			return
+		}
+	}
+	start := (page - 1) * size
+	end := start + size
+	if end > len(posts) {
+		end = len(posts)
+	}
+
+	var list []BlogPost
+	body := &bytes.Buffer{}
+	fmt.Fprintf(body, "<h1>%s</h1>\n", template.HTMLEscapeString(title))
+	if cfg.Description != "" {
+		fmt.Fprintf(body, "<p>%s</p>\n", template.HTMLEscapeString(cfg.Description))
+	}
+	for _, a := range posts[start:end] {
+		list = append(list, BlogPost{
+			URL:     a.url,
+			Title:   articleTitle(a),
+			Date:    a.meta.dateString(),
+			Author:  a.meta.author,
+			Excerpt: template.HTML(a.excerpt),
+			Tags:    a.meta.tags,
+		})
+		fmt.Fprintf(body, "<div class=\"post\">\n<h2><a href=\"%s\">%s</a></h2>\n<p class=\"post-meta\">",
+			a.url, template.HTMLEscapeString(articleTitle(a)))
+		if date := a.meta.dateString(); date != "" {
+			fmt.Fprintf(body, "<i class=\"fa fa-calendar\"></i> %s ", template.HTMLEscapeString(date))
+		}
+		if a.meta.author != "" {
+			fmt.Fprintf(body, "<i class=\"fa fa-user\"></i> %s ", template.HTMLEscapeString(a.meta.author))
+		}
+		for _, tag := range a.meta.tags {
+			fmt.Fprintf(body, "<a class=\"tag\" href=\"%s\">%s</a> ", tagURL(tag), template.HTMLEscapeString(tag))
+		}
+		fmt.Fprintf(body, "</p>\n%s\n<p><a href=\"%s\">Read more &raquo;</a></p>\n</div>\n", a.excerpt, a.url)
+	}
+	if pages > 1 {
+		body.WriteString("<p class=\"pagination\">")
+		if page > 1 {
+			fmt.Fprintf(body, "<a href=\"?page=%d\"><i class=\"fa fa-angle-double-left\"></i> Newer</a> ", page-1)
+		}
+		fmt.Fprintf(body, "%d / %d", page, pages)
+		if page < pages {
+			fmt.Fprintf(body, " <a href=\"?page=%d\">Older <i class=\"fa fa-angle-double-right\"></i></a>", page+1)
+		}
+		body.WriteString("</p>\n")
+	}
+
+	parent := link[:strings.LastIndex(strings.TrimSuffix(link, "/"), "/")+1]
+	articleMeta := fmt.Sprintf(`<a href="%s"><i class="fa fa-home catalog"></i></a>`, parent) +
+		`<a href="?list=1"><i class="fa fa-list catalog"></i></a>` + feedLinks(link)
+	m := map[string]interface{}{
+		"title":       title,
+		"head":        feedHead(link),
+		"mdbody":      body.String(),
+		"articlemeta": articleMeta,
+		"posts":       list,
+		"page":        page,
+		"pages":       pages,
+	}
+	rw.Header().Set("content-type", "text/html; charset=utf-8")
+	s.executePage(rw, dir, "blog", m)
+}
@@ -0,0 +1,252 @@
+package fileserver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// archiveSep separates the path of an archive from the path of an entry
+// inside it, as in /releases/app.zip/!/bin/tool.
+const archiveSep = "/!/"
+
+// Limits of browsing archives, against zip bombs and archives made to
+// tie the server up.
+const (
+	archiveMaxEntries = 100000  // entries of one archive
+	archiveMaxSize    = 1 << 30 // uncompressed size of a compressed entry
+	archiveMaxRatio   = 200     // compression ratio of a zip entry
+	archiveMaxScan    = 4 << 30 // bytes decompressed reading a tarball
+)
+
+// errArchiveLimit refuses archives and entries beyond the limits.
+var errArchiveLimit = errors.New("archive exceeds the browsing limits")
+
+// browsable lists the archive types that can be browsed.
+var browsable = []string{".zip", ".tar", ".tar.gz", ".tgz"}
+
+// isArchive reports whether name is an archive that can be browsed.
+func isArchive(name string) bool {
+	lower := strings.ToLower(name)
+	for _, ext := range browsable {
+		if strings.HasSuffix(lower, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitArchivePath splits a URL path into the name of a browsable archive
+// and the name of an entry inside it.
+func splitArchivePath(upath string) (archive, entry string, ok bool) {
+	upath += "/"
+	for i := 0; ; {
+		j := strings.Index(upath[i:], archiveSep)
+		if j < 0 {
+			return "", "", false
+		}
+		i += j
+		if isArchive(upath[:i]) {
+			return fsName(upath[:i]), fsName(upath[i+len(archiveSep):]), true
+		}
+		i++
+	}
+}
+
+// archiveHandler lists the directories of an archive with the listing of
+// real ones, and streams its files, without extracting the archive.
+func (s *Server) archiveHandler(rw http.ResponseWriter, req *http.Request, archive, entry string) {
+	fail := func(err error) {
+		if isNotFound(err) {
+			s.httpError(rw, req, http.StatusNotFound, nil)
+		} else {
+			s.httpError(rw, req, errorStatus(err), err)
+		}
+	}
+	rw.Header().Set("X-Robots-Tag", "noindex")
+	finfo, err := s.stat(archive)
+	if err == nil && (finfo.IsDir() || s.ignored(archive, false)) {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		fail(err)
+		return
+	}
+	fsys, closer, err := s.openArchive(archive, finfo)
+	if err != nil {
+		fail(err)
+		return
+	}
+	defer closer.Close()
+
+	einfo, err := fs.Stat(fsys, entry)
+	if err == nil && s.ignored(path.Join(archive, entry), einfo.IsDir()) {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		fail(err)
+		return
+	}
+	if einfo.IsDir() {
+		list, err := fs.ReadDir(fsys, entry)
+		if err != nil {
+			fail(err)
+			return
+		}
+		var visible []os.FileInfo
+		for _, e := range list {
+			if s.ignored(path.Join(archive, entry, e.Name()), e.IsDir()) {
+				continue
+			}
+			if info, err := e.Info(); err == nil {
+				visible = append(visible, info)
+			}
+		}
+		// the root of the archive leads back to the archive's directory
+		up := ".."
+		if entry == "." {
+			up = "../.."
+		}
+		s.writeListing(rw, req, path.Dir(archive), archive+archiveSep+entry, up, visible, "", "")
+		return
+	}
+
+	if err := checkArchiveEntry(einfo); err != nil {
+		fail(err)
+		return
+	}
+	f, err := fsys.Open(entry)
+	if err != nil {
+		fail(err)
+		return
+	}
+	defer f.Close()
+	if rs, ok := f.(io.ReadSeeker); ok {
+		http.ServeContent(rw, req, einfo.Name(), einfo.ModTime(), rs)
+		return
+	}
+	serveStream(rw, req, einfo, f)
+}
+
+// checkArchiveEntry refuses compressed entries too large to decompress, or
+// compressed suspiciously well. Entries holding more than they claim fail
+// as they are read.
+func checkArchiveEntry(info fs.FileInfo) error {
+	if fh, ok := info.Sys().(*zip.FileHeader); ok && fh.Method != zip.Store {
+		if fh.UncompressedSize64 > archiveMaxSize ||
+			fh.UncompressedSize64 > 1<<20 && fh.UncompressedSize64 > archiveMaxRatio*fh.CompressedSize64 {
+			return errArchiveLimit
+		}
+	}
+	return nil
+}
+
+// openArchive opens the archive name for browsing. Indexes of gzipped
+// tarballs, which take reading them whole, are cached.
+func (s *Server) openArchive(name string, finfo os.FileInfo) (fs.FS, io.Closer, error) {
+	lower := strings.ToLower(name)
+	if strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz") {
+		key := newCacheKey(name, "archive", finfo)
+		if v, ok := s.cache.get(key); ok {
+			return v.(*tarFS), io.NopCloser(nil), nil
+		}
+		t, err := s.readTarGz(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.cache.put(key, t, int64(len(t.entries))*256)
+		return t, io.NopCloser(nil), nil
+	}
+
+	f, err := s.fsys.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, ok := f.(io.ReaderAt)
+	if !ok {
+		f.Close()
+		return nil, nil, errors.New("archive cannot be read in place")
+	}
+	var fsys fs.FS
+	if strings.HasSuffix(lower, ".zip") {
+		var z *zipFS
+		if z, err = newZipFS(r, finfo.Size()); err == nil && len(z.File) > archiveMaxEntries {
+			err = errArchiveLimit
+		}
+		fsys = z
+	} else {
+		sr := io.NewSectionReader(r, 0, finfo.Size())
+		var t *tarFS
+		t, err = readTar(tar.NewReader(sr), func() (int64, error) {
+			return sr.Seek(0, io.SeekCurrent)
+		}, archiveMaxEntries)
+		if t != nil {
+			t.r = r
+		}
+		fsys = t
+	}
+	if err == errTooManyEntries {
+		err = errArchiveLimit
+	}
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return fsys, f, nil
+}
+
+// readTarGz indexes the gzipped tarball name, whose members are then read
+// by decompressing it again up to them.
+func (s *Server) readTarGz(name string) (*tarFS, error) {
+	stream := func() (*tar.Reader, io.Closer, error) {
+		f, err := s.fsys.Open(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return tar.NewReader(&scanLimit{zr, archiveMaxScan}), f, nil
+	}
+	tr, c, err := stream()
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	t, err := readTar(tr, nil, archiveMaxEntries)
+	if err == errTooManyEntries {
+		err = errArchiveLimit
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.stream = stream
+	return t, nil
+}
+
+// scanLimit fails reads beyond n bytes.
+type scanLimit struct {
+	r io.Reader
+	n int64
+}
+
+func (l *scanLimit) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		return 0, errArchiveLimit
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	return n, err
+}
@@ -0,0 +1,153 @@
+package fileserver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSplitArchivePath(t *testing.T) {
+	tests := []struct {
+		upath          string
+		archive, entry string
+		ok             bool
+	}{
+		{"/a.zip/!/bin/tool", "a.zip", "bin/tool", true},
+		{"/a.zip/!/", "a.zip", ".", true},
+		{"/a.zip/!", "a.zip", ".", true},
+		{"/dir/App.TGZ/!/x", "dir/App.TGZ", "x", true},
+		{"/a.tar.gz/!/b.zip/!/c", "a.tar.gz", "b.zip/!/c", true},
+		{"/notes/!/a.zip/!/x", "notes/!/a.zip", "x", true},
+		{"/a.zip", "", "", false},
+		{"/a.txt/!/x", "", "", false},
+		{"/a.zip/x", "", "", false},
+	}
+	for _, tt := range tests {
+		archive, entry, ok := splitArchivePath(tt.upath)
+		if archive != tt.archive || entry != tt.entry || ok != tt.ok {
+			t.Errorf("splitArchivePath(%q) = %q, %q, %v, want %q, %q, %v",
+				tt.upath, archive, entry, ok, tt.archive, tt.entry, tt.ok)
+		}
+	}
+}
+
+func TestCheckArchiveEntry(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       uint16
+		compressed   uint64
+		uncompressed uint64
+		ok           bool
+	}{
+		{"small", zip.Deflate, 100, 1000, true},
+		{"well compressed but small", zip.Deflate, 10, 1 << 20, true},
+		{"ratio at the limit", zip.Deflate, 1 << 20, archiveMaxRatio << 20, true},
+		{"ratio beyond the limit", zip.Deflate, 1 << 20, archiveMaxRatio<<20 + 1, false},
+		{"too large", zip.Deflate, archiveMaxSize, archiveMaxSize + 1, false},
+		{"stored, read as it is", zip.Store, archiveMaxSize + 1, archiveMaxSize + 1, true},
+	}
+	for _, tt := range tests {
+		fh := &zip.FileHeader{Name: "f", Method: tt.method,
+			CompressedSize64: tt.compressed, UncompressedSize64: tt.uncompressed}
+		err := checkArchiveEntry(fh.FileInfo())
+		if ok := err == nil; ok != tt.ok {
+			t.Errorf("%s: checkArchiveEntry = %v", tt.name, err)
+		}
+	}
+	// tar members are read as they are
+	hdr := &tar.Header{Name: "f", Size: archiveMaxSize * 2, Typeflag: tar.TypeReg}
+	if err := checkArchiveEntry(hdr.FileInfo()); err != nil {
+		t.Errorf("tar member: %v", err)
+	}
+}
+
+func tarball(t *testing.T, n int) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for i := 0; i < n; i++ {
+		name := string(rune('a'+i%26)) + strings.Repeat("x", i/26)
+		if err := tw.WriteHeader(&tar.Header{Name: name, Size: 1, Mode: 0644, Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write([]byte("1"))
+	}
+	tw.Close()
+	return buf.Bytes()
+}
+
+func TestReadTarMaxEntries(t *testing.T) {
+	tests := []struct {
+		members, max int
+		err          error
+	}{
+		{5, 0, nil},
+		{5, 5, nil},
+		{5, 6, nil},
+		{6, 5, errTooManyEntries},
+		{50, 5, errTooManyEntries},
+	}
+	for _, tt := range tests {
+		data := tarball(t, tt.members)
+		_, err := readTar(tar.NewReader(bytes.NewReader(data)), nil, tt.max)
+		if err != tt.err {
+			t.Errorf("%d members, at most %d: %v, want %v", tt.members, tt.max, err, tt.err)
+		}
+	}
+}
+
+func TestScanLimit(t *testing.T) {
+	l := &scanLimit{strings.NewReader(strings.Repeat("x", 100)), 64}
+	data, err := io.ReadAll(l)
+	if len(data) != 64 || err != errArchiveLimit {
+		t.Errorf("read %d bytes, %v, want 64, %v", len(data), err, errArchiveLimit)
+	}
+}
+
+// TestArchiveLimits serves entries of a zip file, refusing the ones that
+// decompress beyond the limits.
+func TestArchiveLimits(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	add := func(name string, method uint16, data []byte) {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+	}
+	add("small.txt", zip.Deflate, []byte("hello"))
+	add("stored.bin", zip.Store, bytes.Repeat([]byte{0}, 2<<20))
+	add("bomb.bin", zip.Deflate, bytes.Repeat([]byte{0}, 2<<20))
+	zw.Close()
+
+	s, err := New(WithFS(fstest.MapFS{"a.zip": {Data: buf.Bytes()}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/a.zip/!/", http.StatusOK},
+		{"/a.zip/!/small.txt", http.StatusOK},
+		{"/a.zip/!/stored.bin", http.StatusOK},
+		{"/a.zip/!/bomb.bin", http.StatusForbidden},
+		{"/a.zip/!/missing", http.StatusNotFound},
+		{"/a.zip/!/small.txt/x", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+		if rec.Code != tt.status {
+			t.Errorf("GET %s: %d, want %d", tt.path, rec.Code, tt.status)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package fileserver
+
+import (
+	"container/list"
+	"os"
+	"path"
+	"strings"
+	"sync"
+)
+
+// cacheKey ties a cached value to the exact file version it was built from,
+// so a changed mtime or size is a miss even if a notification was lost.
+type cacheKey struct {
+	path  string
+	kind  string
+	mtime int64
+	size  int64
+}
+
+func newCacheKey(name, kind string, finfo os.FileInfo) cacheKey {
+	return cacheKey{name, kind, finfo.ModTime().UnixNano(), finfo.Size()}
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	value interface{}
+	size  int64
+}
+
+// lruCache is a size-bounded LRU for file bytes and rendered pages.
+// A nil *lruCache is a valid, always-missing cache.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int64
+	used     int64
+	ll       *list.List
+	items    map[cacheKey]*list.Element
+	watch    func(dir string)
+
+	hits, misses, evictions, invalidations int64
+}
+
+func newLRUCache(capacity int64) *lruCache {
+	if capacity <= 0 {
+		return nil
+	}
+	return &lruCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    map[cacheKey]*list.Element{},
+	}
+}
+
+func (c *lruCache) get(key cacheKey) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.ll.MoveToFront(e)
+		c.hits++
+		return e.Value.(*cacheEntry).value, true
+	}
+	c.misses++
+	return nil, false
+}
+
+func (c *lruCache) put(key cacheKey, value interface{}, size int64) {
+	if c == nil || size > c.capacity {
+		return
+	}
+	if c.watch != nil {
+		c.watch(path.Dir(key.path))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.removeElement(e)
+	}
+	c.items[key] = c.ll.PushFront(&cacheEntry{key, value, size})
+	c.used += size
+	for c.used > c.capacity {
+		c.removeElement(c.ll.Back())
+		c.evictions++
+	}
+}
+
+// invalidate drops every entry for name, or below it when name is a directory.
+func (c *lruCache) invalidate(name string) {
+	if c == nil {
+		return
+	}
+	prefix := name + "/"
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for e := c.ll.Front(); e != nil; {
+		next := e.Next()
+		p := e.Value.(*cacheEntry).key.path
+		if p == name || strings.HasPrefix(p, prefix) {
+			c.removeElement(e)
+			c.invalidations++
+		}
+		e = next
+	}
+}
+
+func (c *lruCache) removeElement(e *list.Element) {
+	ent := c.ll.Remove(e).(*cacheEntry)
+	delete(c.items, ent.key)
+	c.used -= ent.size
+}
+
+func (c *lruCache) stats() map[string]int64 {
+	if c == nil {
+		return map[string]int64{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return map[string]int64{
+		"capacity":      c.capacity,
+		"bytes":         c.used,
+		"entries":       int64(c.ll.Len()),
+		"hits":          c.hits,
+		"misses":        c.misses,
+		"evictions":     c.evictions,
+		"invalidations": c.invalidations,
+	}
+}
@@ -0,0 +1,266 @@
+package fileserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/textproto"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// scriptBackend reports whether name is run rather than served, as a CGI
+// script or by the FastCGI responder at addr.
+func (s *Server) scriptBackend(name string) (addr string, ok bool) {
+	ext := strings.ToLower(path.Ext(name))
+	if addr, ok := s.fastCGI[ext]; ok {
+		return addr, true
+	}
+	for _, e := range s.cgiExts {
+		if ext == e {
+			return "", true
+		}
+	}
+	return "", s.inCGIDir(path.Dir(name))
+}
+
+// inCGIDir reports whether the directory name is, or lies below, one of
+// WithCGI.
+func (s *Server) inCGIDir(name string) bool {
+	for _, dir := range s.cgiDirs {
+		if name == dir || dir == "." || strings.HasPrefix(name, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// findScript looks for a script along the URL path upath, which may go on
+// past it into the script's PATH_INFO, as in /cgi-bin/app.py/users/1.
+func (s *Server) findScript(upath string) (script, pathInfo string, finfo os.FileInfo, ok bool) {
+	if len(s.cgiDirs)+len(s.cgiExts)+len(s.fastCGI) == 0 {
+		return "", "", nil, false
+	}
+	name := fsName(upath)
+	if name == "." {
+		return "", "", nil, false
+	}
+	parts := strings.Split(name, "/")
+	for i := range parts {
+		script = strings.Join(parts[:i+1], "/")
+		if _, ok := s.scriptBackend(script); !ok {
+			continue
+		}
+		finfo, err := s.stat(script)
+		if err != nil {
+			return "", "", nil, false
+		}
+		if finfo.IsDir() {
+			continue
+		}
+		if s.ignored(script, false) {
+			return "", "", nil, false
+		}
+		if i+1 < len(parts) {
+			pathInfo = "/" + strings.Join(parts[i+1:], "/")
+			if strings.HasSuffix(upath, "/") {
+				pathInfo += "/"
+			}
+		}
+		return script, pathInfo, finfo, true
+	}
+	return "", "", nil, false
+}
+
+// scriptHandler runs the script name for req, as a CGI process or through
+// its FastCGI responder, within the limits of WithCGILimits.
+func (s *Server) scriptHandler(rw http.ResponseWriter, req *http.Request, name, pathInfo string, finfo os.FileInfo) {
+	root, fpath := s.realPath(name)
+	addr, _ := s.scriptBackend(name)
+	if addr == "" && (fpath == "" || !finfo.Mode().IsRegular() || finfo.Mode()&0111 == 0) {
+		s.httpError(rw, req, http.StatusForbidden, fmt.Errorf("%s is not an executable CGI script", name))
+		return
+	}
+	if req.ContentLength < 0 {
+		// scripts learn the length of the body up front
+		s.httpError(rw, req, http.StatusLengthRequired, nil)
+		return
+	}
+	select {
+	case s.cgiSlots <- struct{}{}:
+		defer func() { <-s.cgiSlots }()
+	default:
+		s.httpError(rw, req, http.StatusServiceUnavailable, fmt.Errorf("%s: too many scripts running", name))
+		return
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), s.cgiTimeout)
+	defer cancel()
+
+	env := cgiEnv(req, name, pathInfo, root, fpath)
+	var out io.ReadCloser
+	var err error
+	if addr != "" {
+		out, err = fastCGIRequest(ctx, addr, env, req.Body, name)
+	} else {
+		out, err = runCGI(ctx, fpath, env, req.Body, name)
+	}
+	if err == nil {
+		err = writeCGIResponse(rw, out)
+		out.Close()
+		if err == nil {
+			return
+		}
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		s.httpError(rw, req, http.StatusGatewayTimeout, fmt.Errorf("%s: timed out", name))
+	} else {
+		s.httpError(rw, req, http.StatusBadGateway, fmt.Errorf("%s: %v", name, err))
+	}
+}
+
+// cgiEnv is the environment RFC 3875 gives the script name for req, with
+// the variables scripts commonly expect besides.
+func cgiEnv(req *http.Request, name, pathInfo, root, fpath string) []string {
+	host, port := req.Host, "80"
+	if req.TLS != nil {
+		port = "443"
+	}
+	if h, p, err := net.SplitHostPort(req.Host); err == nil {
+		host, port = h, p
+	}
+	remoteAddr, remotePort, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		remoteAddr = req.RemoteAddr
+	}
+	env := []string{
+		"GATEWAY_INTERFACE=CGI/1.1",
+		"SERVER_SOFTWARE=gohttpserver",
+		"SERVER_PROTOCOL=" + req.Proto,
+		"SERVER_NAME=" + host,
+		"SERVER_PORT=" + port,
+		"REQUEST_METHOD=" + req.Method,
+		"REQUEST_URI=" + req.URL.RequestURI(),
+		"QUERY_STRING=" + req.URL.RawQuery,
+		"SCRIPT_NAME=/" + name,
+		"SCRIPT_FILENAME=" + fpath,
+		"DOCUMENT_ROOT=" + root,
+		"PATH_INFO=" + pathInfo,
+		"REMOTE_ADDR=" + remoteAddr,
+		"REMOTE_HOST=" + remoteAddr,
+		"REMOTE_PORT=" + remotePort,
+		// PHP refuses to run without it when called as CGI
+		"REDIRECT_STATUS=200",
+	}
+	if pathInfo != "" && root != "" {
+		env = append(env, "PATH_TRANSLATED="+filepath.Join(root, filepath.FromSlash(pathInfo)))
+	}
+	if req.TLS != nil {
+		env = append(env, "HTTPS=on")
+	}
+	if req.ContentLength > 0 {
+		env = append(env, "CONTENT_LENGTH="+strconv.FormatInt(req.ContentLength, 10))
+	}
+	if ctype := req.Header.Get("Content-Type"); ctype != "" {
+		env = append(env, "CONTENT_TYPE="+ctype)
+	}
+	if user, _, ok := req.BasicAuth(); ok {
+		env = append(env, "AUTH_TYPE=Basic", "REMOTE_USER="+user)
+	}
+	for k, v := range req.Header {
+		k = strings.ToUpper(strings.Replace(k, "-", "_", -1))
+		switch k {
+		case "CONTENT_TYPE", "CONTENT_LENGTH", "AUTHORIZATION":
+			continue
+		case "PROXY":
+			// HTTP_PROXY would point HTTP clients of scripts at the
+			// client's proxy (httpoxy)
+			continue
+		}
+		sep := ", "
+		if k == "COOKIE" {
+			sep = "; "
+		}
+		env = append(env, "HTTP_"+k+"="+strings.Join(v, sep))
+	}
+	return env
+}
+
+// runCGI starts the CGI script fpath and returns its output. The script
+// is killed once ctx is done; processes it started themselves get a second
+// to let go of its output before it is closed on them.
+func runCGI(ctx context.Context, fpath string, env []string, body io.Reader, name string) (io.ReadCloser, error) {
+	cmd := exec.CommandContext(ctx, fpath)
+	cmd.Dir = filepath.Dir(fpath)
+	cmd.Env = append(env, "PATH="+os.Getenv("PATH"))
+	cmd.Stdin = body
+	cmd.Stderr = &stderrLog{name}
+	cmd.WaitDelay = time.Second
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &cgiProcess{out, cmd}, nil
+}
+
+// cgiProcess is the output of a running script, waited for on Close.
+type cgiProcess struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+}
+
+func (p *cgiProcess) Close() error {
+	p.ReadCloser.Close()
+	return p.cmd.Wait()
+}
+
+// stderrLog logs what a script writes to its stderr.
+type stderrLog struct {
+	name string
+}
+
+func (l *stderrLog) Write(p []byte) (int, error) {
+	for _, line := range bytes.Split(bytes.TrimRight(p, "\n"), []byte("\n")) {
+		log.Printf("%s: %s", l.name, line)
+	}
+	return len(p), nil
+}
+
+// writeCGIResponse answers with the output of a script: header lines, of
+// which Status sets the status code, an empty line and the body.
+func writeCGIResponse(rw http.ResponseWriter, out io.Reader) error {
+	br := bufio.NewReader(out)
+	header, err := textproto.NewReader(br).ReadMIMEHeader()
+	if err != nil {
+		return fmt.Errorf("bad response header: %v", err)
+	}
+	status := http.StatusOK
+	if v := header.Get("Status"); v != "" {
+		code, err := strconv.Atoi(strings.SplitN(v, " ", 2)[0])
+		if err != nil || code < 100 || code > 999 {
+			return fmt.Errorf("bad status %q", v)
+		}
+		status = code
+		header.Del("Status")
+	} else if header.Get("Location") != "" {
+		status = http.StatusFound
+	}
+	for k, v := range header {
+		rw.Header()[k] = v
+	}
+	rw.WriteHeader(status)
+	io.Copy(rw, br)
+	return nil
+}
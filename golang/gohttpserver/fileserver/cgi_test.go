@@ -0,0 +1,144 @@
+package fileserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWriteCGIResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		out    string
+		status int
+		header http.Header
+		body   string
+		bad    bool
+	}{
+		{
+			name:   "plain",
+			out:    "Content-Type: text/plain\r\n\r\nhello",
+			status: 200,
+			header: http.Header{"Content-Type": {"text/plain"}},
+			body:   "hello",
+		},
+		{
+			name:   "bare newlines",
+			out:    "Content-Type: text/plain\nX-A: 1\n\nhello\n",
+			status: 200,
+			header: http.Header{"Content-Type": {"text/plain"}, "X-A": {"1"}},
+			body:   "hello\n",
+		},
+		{
+			name:   "status",
+			out:    "Status: 404 Not Found\r\nContent-Type: text/html\r\n\r\ngone",
+			status: 404,
+			header: http.Header{"Content-Type": {"text/html"}},
+			body:   "gone",
+		},
+		{
+			name:   "status code alone",
+			out:    "Status: 201\r\n\r\n",
+			status: 201,
+			header: http.Header{},
+		},
+		{
+			name:   "redirect",
+			out:    "Location: /next\r\n\r\n",
+			status: 302,
+			header: http.Header{"Location": {"/next"}},
+		},
+		{
+			name:   "redirect with status",
+			out:    "Status: 301 Moved Permanently\r\nLocation: /next\r\n\r\n",
+			status: 301,
+			header: http.Header{"Location": {"/next"}},
+		},
+		{
+			name:   "repeated headers",
+			out:    "Set-Cookie: a=1\r\nSet-Cookie: b=2\r\n\r\n",
+			status: 200,
+			header: http.Header{"Set-Cookie": {"a=1", "b=2"}},
+		},
+		{name: "status not a number", out: "Status: OK\r\n\r\n", bad: true},
+		{name: "status out of range", out: "Status: 42\r\n\r\n", bad: true},
+		{name: "no header end", out: "Content-Type: text/plain\r\n", bad: true},
+		{name: "not a header", out: "hello world\r\n\r\n", bad: true},
+		{name: "empty", out: "", bad: true},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		err := writeCGIResponse(rec, strings.NewReader(tt.out))
+		if tt.bad {
+			if err == nil {
+				t.Errorf("%s: accepted %q", tt.name, tt.out)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if rec.Code != tt.status {
+			t.Errorf("%s: status %d, want %d", tt.name, rec.Code, tt.status)
+		}
+		for k, v := range tt.header {
+			if got := rec.Header()[k]; strings.Join(got, "|") != strings.Join(v, "|") {
+				t.Errorf("%s: %s is %q, want %q", tt.name, k, got, v)
+			}
+		}
+		if len(rec.Header()) != len(tt.header) {
+			t.Errorf("%s: header %v, want %v", tt.name, rec.Header(), tt.header)
+		}
+		if rec.Body.String() != tt.body {
+			t.Errorf("%s: body %q, want %q", tt.name, rec.Body, tt.body)
+		}
+	}
+}
+
+func TestCGIEnv(t *testing.T) {
+	req := httptest.NewRequest("POST", "http://example.com:8080/cgi-bin/app.py/users/1?x=1", strings.NewReader("a=b"))
+	req.RemoteAddr = "10.0.0.1:5555"
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Proxy", "http://evil:3128")
+	req.Header.Set("Authorization", "Basic dTpw")
+	req.Header.Add("Cookie", "a=1")
+	req.Header.Add("Cookie", "b=2")
+	env := map[string]string{}
+	for _, kv := range cgiEnv(req, "cgi-bin/app.py", "/users/1", "/srv", "/srv/cgi-bin/app.py") {
+		k, v, _ := strings.Cut(kv, "=")
+		if _, ok := env[k]; ok {
+			t.Errorf("%s set twice", k)
+		}
+		env[k] = v
+	}
+	want := map[string]string{
+		"REQUEST_METHOD":  "POST",
+		"SCRIPT_NAME":     "/cgi-bin/app.py",
+		"SCRIPT_FILENAME": "/srv/cgi-bin/app.py",
+		"PATH_INFO":       "/users/1",
+		"PATH_TRANSLATED": "/srv/users/1",
+		"QUERY_STRING":    "x=1",
+		"REQUEST_URI":     "/cgi-bin/app.py/users/1?x=1",
+		"SERVER_NAME":     "example.com",
+		"SERVER_PORT":     "8080",
+		"REMOTE_ADDR":     "10.0.0.1",
+		"REMOTE_PORT":     "5555",
+		"CONTENT_LENGTH":  "3",
+		"CONTENT_TYPE":    "application/x-www-form-urlencoded",
+		"AUTH_TYPE":       "Basic",
+		"REMOTE_USER":     "u",
+		"HTTP_COOKIE":     "a=1; b=2",
+	}
+	for k, v := range want {
+		if env[k] != v {
+			t.Errorf("%s=%q, want %q", k, env[k], v)
+		}
+	}
+	for _, k := range []string{"HTTP_PROXY", "HTTP_AUTHORIZATION", "HTTP_CONTENT_TYPE", "HTTPS"} {
+		if v, ok := env[k]; ok {
+			t.Errorf("%s=%q set", k, v)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+package fileserver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// commentsDir holds the threads of the markdown files of its parent
+// directory, one <name>.json per page. It is never served.
+const commentsDir = ".comments"
+
+// commentsConfig picks the comment provider of a directory in .serve.yml:
+//
+//	comments:
+//	  provider: local     # none, embed or local
+//	  moderate: true      # hold new comments until approved
+//	  per_hour: 5         # comments one address may post per hour
+//
+// The embed provider inserts embed, an html/template snippet given url,
+// path and title of the page, for third-party services. A page can turn
+// comments off with "comments: false" in its front matter.
+type commentsConfig struct {
+	Provider  string `yaml:"provider"`
+	Embed     string `yaml:"embed"`
+	Moderate  bool   `yaml:"moderate"`
+	PerHour   int    `yaml:"per_hour"`
+	MaxLength int    `yaml:"max_length"`
+}
+
+type comment struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Body     string    `json:"body"`
+	Time     time.Time `json:"time"`
+	Approved bool      `json:"approved"`
+	IP       string    `json:"ip,omitempty"`
+}
+
+func (s *Server) allowComment(ip string, perHour int) bool {
+	if perHour <= 0 {
+		perHour = 5
+	}
+	limiter := &s.commentLimiter
+	limiter.Lock()
+	defer limiter.Unlock()
+	now := time.Now()
+	for addr, times := range limiter.m {
+		for len(times) != 0 && now.Sub(times[0]) > time.Hour {
+			times = times[1:]
+		}
+		if len(times) == 0 {
+			delete(limiter.m, addr)
+		} else {
+			limiter.m[addr] = times
+		}
+	}
+	if len(limiter.m[ip]) >= perHour {
+		return false
+	}
+	limiter.m[ip] = append(limiter.m[ip], now)
+	return true
+}
+
+func threadPath(name string) string {
+	return path.Join(path.Dir(name), commentsDir, path.Base(name)+".json")
+}
+
+// isPage reports whether name is a page that is there and not ignored, the
+// only files threads are kept for.
+func (s *Server) isPage(name string) bool {
+	finfo, err := s.stat(name)
+	return err == nil && !finfo.IsDir() && s.rendered(name) && !s.ignored(name, false)
+}
+
+func (s *Server) readThread(name string) ([]comment, error) {
+	data, err := fs.ReadFile(s.fsys, threadPath(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var list []comment
+	err = json.Unmarshal(data, &list)
+	return list, err
+}
+
+func (s *Server) writeThread(name string, list []comment) error {
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.writeFile(threadPath(name), data)
+}
+
+// commentsHTML renders the comments section of the page name. Scripts of
+// the embed snippet run with nonce.
+func (s *Server) commentsHTML(req *http.Request, name string, meta *frontMatter, nonce string) string {
+	cfg := s.dirConfigFor(path.Dir(name)).Comments
+	if cfg == nil || stringParam(meta.params["comments"]) == "false" {
+		return ""
+	}
+	switch cfg.Provider {
+	case "embed":
+		// the nonce goes on the snippet's own scripts only, not on any
+		// the page's title might smuggle in
+		t, err := template.New("embed").Parse(withNonce(cfg.Embed, nonce))
+		if err != nil {
+			log.Printf("comments embed: %v", err)
+			return ""
+		}
+		buf := &bytes.Buffer{}
+		t.Execute(buf, map[string]string{
+			"url":   s.baseURL(req) + req.URL.EscapedPath(),
+			"path":  req.URL.EscapedPath(),
+			"title": meta.title,
+		})
+		return buf.String()
+	case "local":
+	default:
+		return ""
+	}
+
+	s.threads.Lock()
+	list, err := s.readThread(name)
+	s.threads.Unlock()
+	if err != nil {
+		log.Printf("%s: %v", threadPath(name), err)
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteString("<h3 id=\"comments\"><i class=\"fa fa-comments\"></i> Comments</h3>\n")
+	for _, c := range list {
+		if !c.Approved {
+			continue
+		}
+		fmt.Fprintf(buf, "<div class=\"comment-item\" id=\"comment-%s\">\n<p class=\"comment-meta\"><i class=\"fa fa-user\"></i> %s <i class=\"fa fa-clock-o\"></i> %s</p>\n<p>%s</p>\n</div>\n",
+			template.HTMLEscapeString(c.ID), template.HTMLEscapeString(c.Name), c.Time.Format("2006-01-02 15:04"),
+			strings.Replace(template.HTMLEscapeString(c.Body), "\n", "<br>\n", -1))
+	}
+	switch req.FormValue("comment") {
+	case "pending":
+		buf.WriteString("<p class=\"comment-note\">Thanks, your comment is awaiting moderation.</p>\n")
+	case "limited":
+		buf.WriteString("<p class=\"comment-note\">Too many comments, please try again later.</p>\n")
+	case "invalid":
+		buf.WriteString("<p class=\"comment-note\">Please fill in your name and a comment.</p>\n")
+	}
+	fmt.Fprintf(buf, `<form class="comment-form" method="post" action="/_comments/?page=%s">
+<input type="text" name="name" placeholder="Name" maxlength="64" required>
+<input type="text" name="website" class="comment-trap" tabindex="-1" autocomplete="off">
+<textarea name="body" rows="5" placeholder="Comment" required></textarea>
+<button type="submit">Post</button>
+</form>
+`, url.QueryEscape(req.URL.Path))
+	return buf.String()
+}
+
+// commentsHandler takes new comments as POST /_comments/?page=<path> and
+// serves the moderation page.
+func (s *Server) commentsHandler(rw http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/_comments/moderate" {
+		s.moderateHandler(rw, req)
+		return
+	}
+	if req.Method != "POST" {
+		s.httpError(rw, req, http.StatusMethodNotAllowed, nil)
+		return
+	}
+	page := path.Clean("/" + req.URL.Query().Get("page"))
+	name := fsName(page)
+	if !s.isPage(name) {
+		s.httpError(rw, req, http.StatusNotFound, nil)
+		return
+	}
+	cfg := s.dirConfigFor(path.Dir(name)).Comments
+	if meta, _ := s.readFrontMatter(name); cfg == nil || cfg.Provider != "local" || stringParam(meta.params["comments"]) == "false" {
+		s.httpError(rw, req, http.StatusNotFound, nil)
+		return
+	}
+	back := (&url.URL{Path: page}).EscapedPath()
+
+	// bots fill in the hidden website field
+	if req.FormValue("website") != "" {
+		http.Redirect(rw, req, back+"?comment=pending#comments", http.StatusSeeOther)
+		return
+	}
+	author := strings.TrimSpace(req.FormValue("name"))
+	body := strings.TrimSpace(strings.Replace(req.FormValue("body"), "\r\n", "\n", -1))
+	max := cfg.MaxLength
+	if max <= 0 {
+		max = 4000
+	}
+	if author == "" || body == "" || utf8.RuneCountInString(author) > 64 || utf8.RuneCountInString(body) > max {
+		http.Redirect(rw, req, back+"?comment=invalid#comments", http.StatusSeeOther)
+		return
+	}
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+	if !s.allowComment(ip, cfg.PerHour) {
+		http.Redirect(rw, req, back+"?comment=limited#comments", http.StatusSeeOther)
+		return
+	}
+
+	id := make([]byte, 8)
+	rand.Read(id)
+	c := comment{
+		ID:       hex.EncodeToString(id),
+		Name:     author,
+		Body:     body,
+		Time:     time.Now(),
+		Approved: !cfg.Moderate,
+		IP:       ip,
+	}
+	s.threads.Lock()
+	list, err := s.readThread(name)
+	if err == nil {
+		err = s.writeThread(name, append(list, c))
+	}
+	s.threads.Unlock()
+	if err != nil {
+		log.Printf("%s: %v", threadPath(name), err)
+		s.httpError(rw, req, http.StatusInternalServerError, nil)
+		return
+	}
+	if !c.Approved {
+		http.Redirect(rw, req, back+"?comment=pending#comments", http.StatusSeeOther)
+		return
+	}
+	http.Redirect(rw, req, back+"#comment-"+c.ID, http.StatusSeeOther)
+}
+
+// moderateHandler lists comments awaiting approval and approves or deletes
+// them. It needs WithCommentsToken.
+func (s *Server) moderateHandler(rw http.ResponseWriter, req *http.Request) {
+	token := req.FormValue("token")
+	if s.commentsToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.commentsToken)) != 1 {
+		s.httpError(rw, req, http.StatusForbidden, nil)
+		return
+	}
+
+	if req.Method == "POST" {
+		name := fsName(req.FormValue("page"))
+		if !s.isPage(name) {
+			s.httpError(rw, req, http.StatusNotFound, nil)
+			return
+		}
+		id, action := req.FormValue("id"), req.FormValue("action")
+		s.threads.Lock()
+		list, err := s.readThread(name)
+		if err == nil {
+			var kept []comment
+			found := false
+			for _, c := range list {
+				if c.ID != id {
+					kept = append(kept, c)
+					continue
+				}
+				found = true
+				if action == "approve" {
+					c.Approved = true
+				}
+				if action != "delete" {
+					kept = append(kept, c)
+				}
+			}
+			if found {
+				err = s.writeThread(name, kept)
+			}
+		}
+		s.threads.Unlock()
+		if err != nil {
+			log.Printf("%s: %v", threadPath(name), err)
+			s.httpError(rw, req, http.StatusInternalServerError, nil)
+			return
+		}
+		http.Redirect(rw, req, "/_comments/moderate?token="+url.QueryEscape(token), http.StatusSeeOther)
+		return
+	}
+
+	type pending struct {
+		page string
+		c    comment
+	}
+	var list []pending
+	s.threads.Lock()
+	fs.WalkDir(s.fsys, ".", func(tpath string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Base(path.Dir(tpath)) != commentsDir || !strings.HasSuffix(tpath, ".json") {
+			return nil
+		}
+		name := path.Join(path.Dir(path.Dir(tpath)), strings.TrimSuffix(d.Name(), ".json"))
+		thread, err := s.readThread(name)
+		if err != nil {
+			log.Printf("%s: %v", tpath, err)
+		}
+		for _, c := range thread {
+			if !c.Approved {
+				list = append(list, pending{urlPath(name), c})
+			}
+		}
+		return nil
+	})
+	s.threads.Unlock()
+	sort.Slice(list, func(i, j int) bool { return list[i].c.Time.Before(list[j].c.Time) })
+
+	body := &bytes.Buffer{}
+	fmt.Fprintf(body, "<h1>Pending comments (%d)</h1>\n", len(list))
+	for _, p := range list {
+		page, _ := url.PathUnescape(p.page)
+		fmt.Fprintf(body, "<div class=\"comment-item\">\n<p class=\"comment-meta\"><a href=\"%s\">%s</a> <i class=\"fa fa-user\"></i> %s (%s) <i class=\"fa fa-clock-o\"></i> %s</p>\n<p>%s</p>\n",
+			p.page, template.HTMLEscapeString(page), template.HTMLEscapeString(p.c.Name), template.HTMLEscapeString(p.c.IP), p.c.Time.Format("2006-01-02 15:04"),
+			strings.Replace(template.HTMLEscapeString(p.c.Body), "\n", "<br>\n", -1))
+		for _, action := range []string{"approve", "delete"} {
+			fmt.Fprintf(body, `<form class="comment-form" method="post"><input type="hidden" name="token" value="%s"><input type="hidden" name="page" value="%s"><input type="hidden" name="id" value="%s"><button name="action" value="%s">%s</button></form>`+"\n",
+				template.HTMLEscapeString(token), template.HTMLEscapeString(page), template.HTMLEscapeString(p.c.ID), action, action)
+		}
+		body.WriteString("</div>\n")
+	}
+	m := map[string]interface{}{
+		"title":  "Pending comments",
+		"mdbody": body.String(),
+	}
+	rw.Header().Set("content-type", "text/html; charset=utf-8")
+	rw.Header().Set("X-Robots-Tag", "noindex")
+	s.executePage(rw, ".", "markdown", m)
+}
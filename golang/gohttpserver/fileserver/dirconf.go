@@ -0,0 +1,143 @@
+package fileserver
+
+import (
+	"io/fs"
+	"log"
+	"os"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+const dirConfigName = ".serve.yml"
+
+// dirConfig holds per-directory settings read from .serve.yml. A directory
+// inherits the settings of its parents and overrides what its own file
+// sets; fields documented as local apply to the declaring directory only.
+type dirConfig struct {
+	// Blog turns the directory listing into a blog index. Local.
+	Blog *blogConfig `yaml:"blog"`
+
+	// Robots is served as /robots.txt when set in the root directory.
+	Robots string `yaml:"robots"`
+
+	// Comments selects the comment provider of markdown pages.
+	Comments *commentsConfig `yaml:"comments"`
+
+	// Theme and CSS style the pages, see WithTheme.
+	Theme string `yaml:"theme"`
+	CSS   string `yaml:"css"`
+
+	// Lang is the language of listings for browsers asking for none we have.
+	Lang string `yaml:"lang"`
+
+	// Readme places the directory's README above or below its listing,
+	// or hides it with "none".
+	Readme string `yaml:"readme"`
+
+	// Index names the index files of the directory, see WithIndex.
+	Index []string `yaml:"index"`
+
+	// SPA serves the index file of the directory for every missing path
+	// below it, see spaFallback. spaRoot is the directory that set it.
+	SPA     *bool `yaml:"spa"`
+	spaRoot string
+
+	// Sanitize sets the HTML allowlist of rendered markdown, see WithSanitize.
+	Sanitize *sanitizeConfig `yaml:"sanitize"`
+
+	// Headers, FrameAncestors and CORS set the security headers of the
+	// directory's responses, see defaultHeaders.
+	Headers        map[string]string `yaml:"headers"`
+	FrameAncestors string            `yaml:"frame_ancestors"`
+	CORS           *corsConfig       `yaml:"cors"`
+
+	// Templates maps page kinds to template files, see WithTemplates.
+	Templates map[string]string `yaml:"templates"`
+}
+
+type blogConfig struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	PageSize    int    `yaml:"page_size"`
+}
+
+// clone copies c deep enough that decoding into the copy leaves c alone.
+func (c *dirConfig) clone() *dirConfig {
+	n := *c
+	n.Blog = nil
+	n.Templates = nil
+	n.SPA = nil
+	if c.Comments != nil {
+		comments := *c.Comments
+		n.Comments = &comments
+	}
+	if c.Sanitize != nil {
+		sanitize := *c.Sanitize
+		sanitize.AllowAttributes = map[string][]string{}
+		for attr, elements := range c.Sanitize.AllowAttributes {
+			sanitize.AllowAttributes[attr] = elements
+		}
+		n.Sanitize = &sanitize
+	}
+	if c.Headers != nil {
+		n.Headers = map[string]string{}
+		for k, v := range c.Headers {
+			n.Headers[k] = v
+		}
+	}
+	if c.CORS != nil {
+		cors := *c.CORS
+		n.CORS = &cors
+	}
+	return &n
+}
+
+// dirConfigFor returns the effective configuration of dir, which must be
+// the name of a directory in the tree.
+func (s *Server) dirConfigFor(dir string) *dirConfig {
+	s.dirConfigs.Lock()
+	defer s.dirConfigs.Unlock()
+	return s.loadDirConfig(dir)
+}
+
+func (s *Server) loadDirConfig(dir string) *dirConfig {
+	if c, ok := s.dirConfigs.m[dir]; ok {
+		return c
+	}
+	parent := &dirConfig{}
+	if dir != "." {
+		parent = s.loadDirConfig(path.Dir(dir))
+	}
+	c := parent.clone()
+
+	name := path.Join(dir, dirConfigName)
+	if data, err := fs.ReadFile(s.fsys, name); err == nil {
+		if err := yaml.Unmarshal(data, c); err != nil {
+			log.Printf("%s: %v", name, err)
+		}
+	} else if !os.IsNotExist(err) {
+		log.Printf("%s: %v", name, err)
+	}
+	c.Templates = mergeTemplatePaths(dir, c.Templates, parent.Templates)
+	if c.SPA == nil {
+		c.spaRoot = parent.spaRoot
+	} else if *c.SPA {
+		c.spaRoot = dir
+	} else {
+		c.spaRoot = ""
+	}
+	s.watch(dir)
+	s.dirConfigs.m[dir] = c
+	return c
+}
+
+// invalidateDirConfig forgets every configuration once any .serve.yml or
+// configured directory changes, since children inherit from it.
+func (s *Server) invalidateDirConfig(name string) {
+	s.dirConfigs.Lock()
+	defer s.dirConfigs.Unlock()
+	if _, ok := s.dirConfigs.m[name]; ok || path.Base(name) == dirConfigName {
+		s.dirConfigs.m = map[string]*dirConfig{}
+	}
+}
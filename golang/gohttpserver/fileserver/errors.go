@@ -0,0 +1,188 @@
+package fileserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"runtime/debug"
+	"strings"
+	"syscall"
+)
+
+type requestIDKey struct{}
+
+// requestID is the ID withRequestID gave req, for log lines.
+func requestID(req *http.Request) string {
+	id, _ := req.Context().Value(requestIDKey{}).(string)
+	return id
+}
+
+// withRequestID tags every request with an ID, taken from a sane
+// X-Request-Id header or made up, and echoes it in the response.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		id := req.Header.Get("X-Request-Id")
+		if len(id) == 0 || len(id) > 64 || strings.Trim(id, "0123456789abcdefABCDEF-") != "" {
+			b := make([]byte, 8)
+			rand.Read(b)
+			id = hex.EncodeToString(b)
+		}
+		rw.Header().Set("X-Request-Id", id)
+		next.ServeHTTP(rw, req.WithContext(context.WithValue(req.Context(), requestIDKey{}, id)))
+	})
+}
+
+// statusWriter remembers whether the response has started.
+type statusWriter struct {
+	http.ResponseWriter
+	wrote bool
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.wrote = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.wrote = true
+		f.Flush()
+	}
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// withRecovery turns a panicking handler into a logged 500. When the
+// response has already started the connection is aborted instead, so the
+// client sees a broken response rather than a truncated one.
+func (s *Server) withRecovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		sw := &statusWriter{ResponseWriter: rw}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler {
+				panic(v)
+			}
+			log.Printf("[%s] panic serving %s: %v\n%s", requestID(req), req.URL.Path, v, debug.Stack())
+			if sw.wrote {
+				panic(http.ErrAbortHandler)
+			}
+			s.httpError(rw, req, http.StatusInternalServerError, nil)
+		}()
+		next.ServeHTTP(sw, req)
+	})
+}
+
+// errorStatus classifies a file system error.
+func errorStatus(err error) int {
+	switch {
+	case isNotFound(err):
+		return http.StatusNotFound
+	case os.IsPermission(err), errors.Is(err, errArchiveLimit):
+		return http.StatusForbidden
+	case errors.Is(err, syscall.EIO), errors.Is(err, syscall.ENOTCONN), errors.Is(err, syscall.ESTALE),
+		errors.Is(err, syscall.ENODEV), errors.Is(err, syscall.EHOSTDOWN), errors.Is(err, syscall.ETIMEDOUT):
+		// the disk or network mount behind the path went away
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// isNotFound reports whether err means there is no such path, counting
+// paths that go on past a file, as /a.txt/x, and invalid names.
+func isNotFound(err error) bool {
+	return os.IsNotExist(err) || errors.Is(err, syscall.ENOTDIR) || errors.Is(err, fs.ErrInvalid)
+}
+
+// httpError answers req with status. Clients preferring JSON get an error
+// object, everyone else the site's <status>.html found nearest to the
+// requested path, or a built-in page. Server errors and err are logged
+// with the request ID.
+func (s *Server) httpError(rw http.ResponseWriter, req *http.Request, status int, err error) {
+	id := requestID(req)
+	if err != nil || status >= 500 {
+		log.Printf("[%s] %s %s: %d %v", id, req.Method, req.URL.Path, status, err)
+	}
+	if status == http.StatusServiceUnavailable {
+		rw.Header().Set("Retry-After", "30")
+	}
+	rw.Header().Del("Content-Length")
+
+	if wantsJSON(req) {
+		rw.Header().Set("content-type", "application/json; charset=utf-8")
+		rw.WriteHeader(status)
+		json.NewEncoder(rw).Encode(map[string]interface{}{
+			"status":     status,
+			"error":      http.StatusText(status),
+			"request_id": id,
+		})
+		return
+	}
+
+	rw.Header().Set("content-type", "text/html; charset=utf-8")
+	if page := s.errorPage(req, status); page != nil {
+		rw.WriteHeader(status)
+		rw.Write(page)
+		return
+	}
+	text := fmt.Sprintf("%d %s", status, http.StatusText(status))
+	body := fmt.Sprintf("<h1>%s</h1>\n", text)
+	if id != "" {
+		body += fmt.Sprintf("<p>Request ID: <code>%s</code></p>\n", template.HTMLEscapeString(id))
+	}
+	rw.WriteHeader(status)
+	s.executePage(rw, ".", "markdown", map[string]interface{}{
+		"title":  text,
+		"mdbody": body,
+	})
+}
+
+// wantsJSON reports whether the client asked for JSON before HTML.
+func wantsJSON(req *http.Request) bool {
+	accept := req.Header.Get("Accept")
+	j := strings.Index(accept, "application/json")
+	h := strings.Index(accept, "text/html")
+	return j >= 0 && (h < 0 || j < h)
+}
+
+// errorPage looks for <status>.html from the requested directory up to
+// the root, skipping hidden directories.
+func (s *Server) errorPage(req *http.Request, status int) []byte {
+	dir := fsName(req.URL.Path)
+	if !strings.HasSuffix(req.URL.Path, "/") {
+		dir = path.Dir(dir)
+	}
+	dir = s.existingDir(dir)
+	name := fmt.Sprintf("%d.html", status)
+	for {
+		if !s.ignored(dir, true) {
+			if page, err := fs.ReadFile(s.fsys, path.Join(dir, name)); err == nil {
+				return page
+			}
+		}
+		if dir == "." {
+			break
+		}
+		dir = path.Dir(dir)
+	}
+	return nil
+}
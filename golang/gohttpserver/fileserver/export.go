@@ -0,0 +1,338 @@
+package fileserver
+
+import (
+	"fmt"
+	stdhtml "html"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// exporter renders the tree of a Server into out as plain files that work
+// from any static host: listings become index.html, markdown pages become
+// .html next to their source, and every link is made relative.
+type exporter struct {
+	s     *Server
+	out   string
+	skip  string // name of out within the tree, if it is there
+	base  *url.URL
+	count int
+}
+
+var linkAttr = regexp.MustCompile(`(\s(?:href|src))="([^"]*)"`)
+var addRowURL = regexp.MustCompile(`(addRow\("(?:[^"\\]|\\.)*",")([^"]*)(",0,)`)
+
+// Export renders the tree into the directory out and returns the number
+// of files written. Feeds are only exported when baseURL, the public URL
+// of the export, is given.
+func (s *Server) Export(out, baseURL string) (int, error) {
+	e := &exporter{s: s}
+	e.out, _ = filepath.Abs(out)
+	if s.dir != "" {
+		if rel, err := filepath.Rel(s.dir, e.out); err == nil && !strings.HasPrefix(rel, "..") {
+			e.skip = filepath.ToSlash(rel)
+		}
+	}
+	if baseURL != "" {
+		u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+		if err != nil || u.Host == "" {
+			return 0, fmt.Errorf("bad base URL %q", baseURL)
+		}
+		e.base = u
+	}
+	err := e.run()
+	return e.count, err
+}
+
+func (e *exporter) run() error {
+	for name, css := range e.s.css {
+		if err := e.write(name, []byte(css)); err != nil {
+			return err
+		}
+	}
+
+	err := fs.WalkDir(e.s.fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name == e.skip {
+			return fs.SkipDir
+		}
+		if name != "." && (strings.HasPrefix(d.Name(), ".") || e.s.ignored(name, d.IsDir())) ||
+			d.IsDir() && e.s.inCGIDir(name) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		upath := path.Join("/", name)
+		if d.IsDir() {
+			return e.exportDir(name, strings.TrimSuffix(upath, "/")+"/")
+		}
+		if _, ok := e.s.scriptBackend(name); ok {
+			// scripts only make sense running, and their source
+			// is nobody's business
+			return nil
+		}
+		finfo, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if err := e.copyFile(name, finfo); err != nil {
+			return err
+		}
+		if e.s.rendered(name) {
+			return e.page(escapePath(upath), e.target(upath, nil))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return e.exportTags()
+}
+
+func (e *exporter) exportDir(dir, upath string) error {
+	if _, err := e.s.stat(path.Join(dir, "index.html")); err == nil {
+		return nil // the copy wins
+	}
+	uri := escapePath(upath)
+	if err := e.page(uri, upath+"index.html"); err != nil {
+		return err
+	}
+	cfg := e.s.dirConfigFor(dir)
+	if cfg.Blog == nil {
+		return nil
+	}
+	if err := e.page(uri+"?list=1", upath+"list.html"); err != nil {
+		return err
+	}
+	size := cfg.Blog.PageSize
+	if size <= 0 {
+		size = 10
+	}
+	for page := 2; (page-1)*size < len(e.s.blogPosts(dir)); page++ {
+		if err := e.page(uri+"?page="+strconv.Itoa(page), upath+"page-"+strconv.Itoa(page)+".html"); err != nil {
+			return err
+		}
+	}
+	return e.feeds(uri, upath)
+}
+
+func (e *exporter) exportTags() error {
+	tags := e.s.tags.get(e.s)
+	if len(tags) == 0 {
+		return nil
+	}
+	if err := e.page("/_tags/", "/_tags/index.html"); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+			log.Printf("export: skipping tag %q, which cannot name a directory", name)
+			continue
+		}
+		if err := e.page(tagURL(name), "/_tags/"+name+"/index.html"); err != nil {
+			return err
+		}
+		if err := e.feeds(tagURL(name), "/_tags/"+name+"/"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// feeds exports the feeds of the blog or tag page at uri into dir. Feeds
+// need absolute links, so they are only written when the base URL is known.
+func (e *exporter) feeds(uri, dir string) error {
+	if e.base == nil {
+		return nil
+	}
+	if err := e.page(uri+"?feed=rss", dir+"rss.xml"); err != nil {
+		return err
+	}
+	return e.page(uri+"?feed=atom", dir+"atom.xml")
+}
+
+// get runs a request through the server's handlers.
+func (e *exporter) get(uri string) ([]byte, error) {
+	req := httptest.NewRequest("GET", uri, nil)
+	req.Host = ""
+	if e.base != nil {
+		req.Host = e.base.Host
+		if e.base.Scheme == "https" {
+			req.Header.Set("X-Forwarded-Proto", "https")
+		}
+	}
+	rec := httptest.NewRecorder()
+	e.s.mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		return nil, fmt.Errorf("%s: %d %s", uri, rec.Code, http.StatusText(rec.Code))
+	}
+	return rec.Body.Bytes(), nil
+}
+
+// page exports the response for the escaped uri to outPath, an unescaped
+// URL path below the output directory. A page that crashes its renderer
+// is logged and left out rather than ending the export.
+func (e *exporter) page(uri, outPath string) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			log.Printf("export: %s: %v", uri, v)
+			err = nil
+		}
+	}()
+	body, err := e.get(uri)
+	if err != nil {
+		return err
+	}
+	switch {
+	case strings.HasSuffix(outPath, ".html"):
+		body = []byte(e.rewrite(string(body), uri, outPath))
+	case strings.HasSuffix(outPath, ".xml"):
+		body = []byte(e.rewriteFeed(string(body)))
+	}
+	return e.write(outPath, body)
+}
+
+// rewrite points every link of a page exported to outPath at the exported
+// files, relative to the page so the tree can live under any prefix.
+func (e *exporter) rewrite(page, uri, outPath string) string {
+	pageURL, _ := url.Parse(uri)
+	fromDir := path.Dir(outPath)
+	page = linkAttr.ReplaceAllStringFunc(page, func(m string) string {
+		sub := linkAttr.FindStringSubmatch(m)
+		ref, err := url.Parse(stdhtml.UnescapeString(sub[2]))
+		if err != nil || ref.Scheme != "" || ref.Host != "" || (ref.Path == "" && ref.RawQuery == "") {
+			return m
+		}
+		abs := pageURL.ResolveReference(ref)
+		rel := relURL(fromDir, e.target(abs.Path, abs.Query()))
+		if ref.Fragment != "" {
+			rel += "#" + ref.EscapedFragment()
+		}
+		return sub[1] + `="` + stdhtml.EscapeString(rel) + `"`
+	})
+	if strings.Contains(page, "addRow(") {
+		dir := strings.TrimSuffix(pageURL.Path, "/") + "/"
+		page = addRowURL.ReplaceAllStringFunc(page, func(m string) string {
+			sub := addRowURL.FindStringSubmatch(m)
+			name, _ := url.PathUnescape(sub[2])
+			return sub[1] + relURL(path.Dir(outPath), e.target(dir+name, nil)) + sub[3]
+		})
+		page = strings.Replace(page, "<head>", "<head>\n<script>var staticIndex = \"index.html\";</script>", 1)
+	}
+	return page
+}
+
+// rewriteFeed points the absolute links of a feed at the exported files
+// below the base URL.
+func (e *exporter) rewriteFeed(feed string) string {
+	host := regexp.MustCompile(`(>|")` + regexp.QuoteMeta(e.base.Scheme+"://"+e.base.Host) + `(/[^<"?]*)(\?[^<"]*)?`)
+	return host.ReplaceAllStringFunc(feed, func(m string) string {
+		sub := host.FindStringSubmatch(m)
+		p, err := url.PathUnescape(sub[2])
+		if err != nil {
+			return m
+		}
+		q, _ := url.ParseQuery(stdhtml.UnescapeString(strings.TrimPrefix(sub[3], "?")))
+		return sub[1] + e.base.String() + escapePath(e.target(p, q))
+	})
+}
+
+// target maps an unescaped URL path and query of the live server to the
+// path of the exported file.
+func (e *exporter) target(p string, q url.Values) string {
+	dir := strings.HasSuffix(p, "/")
+	if strings.HasPrefix(p, "/_tags/") {
+		dir = true
+	} else if finfo, err := e.s.stat(fsName(p)); err == nil && finfo.IsDir() {
+		dir = true
+	}
+	if dir {
+		p = strings.TrimSuffix(p, "/") + "/"
+	}
+	switch {
+	case q.Get("feed") == "rss" || q.Get("feed") == "atom":
+		return p + q.Get("feed") + ".xml"
+	case q.Get("page") != "" && q.Get("page") != "1":
+		return p + "page-" + q.Get("page") + ".html"
+	case q.Get("list") == "1":
+		return p + "list.html"
+	case dir:
+		return p + "index.html"
+	case e.s.rendered(p) && q.Get("raw") != "1":
+		page := strings.TrimSuffix(p, path.Ext(p)) + ".html"
+		if _, err := e.s.stat(fsName(page)); err == nil {
+			return p + ".html"
+		}
+		return page
+	}
+	return p
+}
+
+// relURL is the escaped relative URL from directory fromDir to file to.
+func relURL(fromDir, to string) string {
+	rel, err := filepath.Rel(filepath.FromSlash(fromDir), filepath.FromSlash(to))
+	if err != nil {
+		return to
+	}
+	rel = filepath.ToSlash(rel)
+	if strings.HasSuffix(to, "/") {
+		rel += "/"
+	}
+	return escapePath(rel)
+}
+
+func escapePath(p string) string {
+	return (&url.URL{Path: p}).EscapedPath()
+}
+
+func (e *exporter) write(upath string, data []byte) error {
+	dst := filepath.Join(e.out, filepath.FromSlash(upath))
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	e.count++
+	return os.WriteFile(dst, data, 0644)
+}
+
+func (e *exporter) copyFile(name string, finfo os.FileInfo) error {
+	dst := filepath.Join(e.out, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	src, err := e.s.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, src); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	e.count++
+	return os.Chtimes(dst, finfo.ModTime(), finfo.ModTime())
+}
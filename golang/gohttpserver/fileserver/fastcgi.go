@@ -0,0 +1,184 @@
+package fileserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+)
+
+// FastCGI record types and the responder role, see the FastCGI
+// specification.
+const (
+	fcgiBeginRequest = 1
+	fcgiEndRequest   = 3
+	fcgiParams       = 4
+	fcgiStdin        = 5
+	fcgiStdout       = 6
+	fcgiStderr       = 7
+
+	fcgiResponder = 1
+
+	fcgiMaxContent = 65535
+)
+
+// fastCGIRequest sends a request with the CGI environment env and body to
+// the responder at addr, host:port or the path of a unix socket, and
+// returns the output of the script. The connection is dropped once ctx
+// is done.
+func fastCGIRequest(ctx context.Context, addr string, env []string, body io.Reader, name string) (io.ReadCloser, error) {
+	network := "tcp"
+	if strings.HasPrefix(addr, "unix:") {
+		network, addr = "unix", strings.TrimPrefix(addr, "unix:")
+	} else if strings.HasPrefix(addr, "/") {
+		network = "unix"
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+
+	w := bufio.NewWriter(conn)
+	// request 1, not keeping the connection
+	writeRecord(w, fcgiBeginRequest, []byte{0, fcgiResponder, 0, 0, 0, 0, 0, 0})
+	var params []byte
+	for _, kv := range env {
+		k, v, _ := strings.Cut(kv, "=")
+		params = appendLength(params, len(k))
+		params = appendLength(params, len(v))
+		params = append(params, k...)
+		params = append(params, v...)
+	}
+	writeStream(w, fcgiParams, params)
+	writeRecord(w, fcgiParams, nil)
+	buf := make([]byte, fcgiMaxContent)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			writeRecord(w, fcgiStdin, buf[:n])
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			stop()
+			conn.Close()
+			return nil, err
+		}
+	}
+	writeRecord(w, fcgiStdin, nil)
+	if err := w.Flush(); err != nil {
+		stop()
+		conn.Close()
+		return nil, err
+	}
+	return &fcgiResponse{conn: conn, r: bufio.NewReader(conn), stop: stop, name: name}, nil
+}
+
+// appendLength appends a name or value length of a FastCGI parameter.
+func appendLength(b []byte, n int) []byte {
+	if n < 128 {
+		return append(b, byte(n))
+	}
+	return binary.BigEndian.AppendUint32(b, uint32(n)|1<<31)
+}
+
+// writeStream writes data as records of typ, as long as they may be.
+func writeStream(w *bufio.Writer, typ byte, data []byte) {
+	for len(data) > 0 {
+		n := len(data)
+		if n > fcgiMaxContent {
+			n = fcgiMaxContent
+		}
+		writeRecord(w, typ, data[:n])
+		data = data[n:]
+	}
+}
+
+// writeRecord writes a record of request 1, padded to eight bytes. Errors
+// surface when w is flushed.
+func writeRecord(w *bufio.Writer, typ byte, content []byte) {
+	pad := -len(content) & 7
+	w.Write([]byte{1, typ, 0, 1, byte(len(content) >> 8), byte(len(content)), byte(pad), 0})
+	w.Write(content)
+	w.Write(make([]byte, pad))
+}
+
+// fcgiResponse reads the stdout stream of a FastCGI response, logging its
+// stderr, until the end of the request.
+type fcgiResponse struct {
+	conn net.Conn
+	r    *bufio.Reader
+	stop func() bool
+	name string
+	left int // bytes left of the current stdout record
+	pad  int // padding after it
+	done bool
+}
+
+func (f *fcgiResponse) Read(p []byte) (int, error) {
+	for f.left == 0 {
+		if f.done {
+			return 0, io.EOF
+		}
+		if _, err := f.r.Discard(f.pad); err != nil {
+			return 0, noEOF(err)
+		}
+		var h [8]byte
+		if _, err := io.ReadFull(f.r, h[:]); err != nil {
+			return 0, noEOF(err)
+		}
+		if h[0] != 1 {
+			return 0, errors.New("bad FastCGI record")
+		}
+		typ, length := h[1], int(h[4])<<8|int(h[5])
+		f.pad = int(h[6])
+		switch typ {
+		case fcgiStdout:
+			f.left = length
+		case fcgiStderr:
+			msg := make([]byte, length)
+			if _, err := io.ReadFull(f.r, msg); err != nil {
+				return 0, noEOF(err)
+			}
+			if len(msg) > 0 {
+				(&stderrLog{f.name}).Write(msg)
+			}
+		case fcgiEndRequest:
+			f.done = true
+			fallthrough
+		default:
+			if _, err := f.r.Discard(length); err != nil {
+				return 0, noEOF(err)
+			}
+		}
+	}
+	if len(p) > f.left {
+		p = p[:f.left]
+	}
+	n, err := f.r.Read(p)
+	f.left -= n
+	return n, noEOF(err)
+}
+
+// noEOF turns the connection ending before the request into an error, so
+// cut off output does not pass for all of it.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (f *fcgiResponse) Close() error {
+	f.stop()
+	return f.conn.Close()
+}
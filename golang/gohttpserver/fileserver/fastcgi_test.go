@@ -0,0 +1,296 @@
+package fileserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"testing"
+)
+
+type fcgiRecord struct {
+	typ     byte
+	content []byte
+}
+
+// readRecords parses FastCGI records, checking their headers and padding.
+func readRecords(t *testing.T, data []byte) []fcgiRecord {
+	t.Helper()
+	var list []fcgiRecord
+	for len(data) > 0 {
+		if len(data) < 8 {
+			t.Fatalf("truncated record header % x", data)
+		}
+		if data[0] != 1 || binary.BigEndian.Uint16(data[2:]) != 1 {
+			t.Fatalf("bad version or request id in % x", data[:8])
+		}
+		length, pad := int(binary.BigEndian.Uint16(data[4:])), int(data[6])
+		if (length+pad)%8 != 0 {
+			t.Errorf("record of %d bytes padded with %d", length, pad)
+		}
+		if len(data) < 8+length+pad {
+			t.Fatalf("truncated record content")
+		}
+		list = append(list, fcgiRecord{data[1], data[8 : 8+length]})
+		data = data[8+length+pad:]
+	}
+	return list
+}
+
+func TestWriteStream(t *testing.T) {
+	tests := []struct {
+		size    int
+		lengths []int
+	}{
+		{0, nil},
+		{1, []int{1}},
+		{8, []int{8}},
+		{fcgiMaxContent, []int{fcgiMaxContent}},
+		{fcgiMaxContent + 1, []int{fcgiMaxContent, 1}},
+		{2*fcgiMaxContent + 100, []int{fcgiMaxContent, fcgiMaxContent, 100}},
+	}
+	for _, tt := range tests {
+		data := bytes.Repeat([]byte("x"), tt.size)
+		buf := &bytes.Buffer{}
+		w := bufio.NewWriter(buf)
+		writeStream(w, fcgiStdin, data)
+		w.Flush()
+		var lengths []int
+		var got []byte
+		for _, rec := range readRecords(t, buf.Bytes()) {
+			if rec.typ != fcgiStdin {
+				t.Errorf("%d bytes: record of type %d", tt.size, rec.typ)
+			}
+			lengths = append(lengths, len(rec.content))
+			got = append(got, rec.content...)
+		}
+		if !equalInts(lengths, tt.lengths) {
+			t.Errorf("%d bytes split into %v, want %v", tt.size, lengths, tt.lengths)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%d bytes: stream not written whole", tt.size)
+		}
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAppendLength(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []byte
+	}{
+		{0, []byte{0}},
+		{127, []byte{127}},
+		{128, []byte{0x80, 0, 0, 128}},
+		{70000, []byte{0x80, 0x01, 0x11, 0x70}},
+	}
+	for _, tt := range tests {
+		if got := appendLength(nil, tt.n); !bytes.Equal(got, tt.want) {
+			t.Errorf("appendLength(%d) = % x, want % x", tt.n, got, tt.want)
+		}
+	}
+}
+
+// records encodes records the way a responder sends them.
+func records(recs ...fcgiRecord) []byte {
+	buf := &bytes.Buffer{}
+	w := bufio.NewWriter(buf)
+	for _, rec := range recs {
+		writeRecord(w, rec.typ, rec.content)
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+func TestFCGIResponse(t *testing.T) {
+	end := fcgiRecord{fcgiEndRequest, make([]byte, 8)}
+	tests := []struct {
+		name   string
+		data   []byte
+		want   string
+		err    error
+		stderr string
+	}{
+		{
+			name: "one record",
+			data: records(fcgiRecord{fcgiStdout, []byte("Status: 200\r\n\r\nhi")}, end),
+			want: "Status: 200\r\n\r\nhi",
+		},
+		{
+			name: "split and padded",
+			data: records(
+				fcgiRecord{fcgiStdout, []byte("a")},
+				fcgiRecord{fcgiStdout, []byte("bcdefghij")},
+				fcgiRecord{fcgiStdout, nil},
+				fcgiRecord{fcgiStdout, bytes.Repeat([]byte("k"), 16)},
+				end),
+			want: "abcdefghij" + strings.Repeat("k", 16),
+		},
+		{
+			name: "stderr between stdout",
+			data: records(
+				fcgiRecord{fcgiStdout, []byte("out1 ")},
+				fcgiRecord{fcgiStderr, []byte("warning: x\nnotice: y\n")},
+				fcgiRecord{fcgiStderr, nil},
+				fcgiRecord{fcgiStdout, []byte("out2")},
+				end),
+			want:   "out1 out2",
+			stderr: "script.php: warning: x\nscript.php: notice: y\n",
+		},
+		{
+			name: "unknown records skipped",
+			data: records(fcgiRecord{11, []byte("xyz")}, fcgiRecord{fcgiStdout, []byte("ok")}, end),
+			want: "ok",
+		},
+		{
+			name: "nothing after the end",
+			data: records(fcgiRecord{fcgiStdout, []byte("ok")}, end, fcgiRecord{fcgiStdout, []byte("late")}),
+			want: "ok",
+		},
+		{
+			name: "no end",
+			data: records(fcgiRecord{fcgiStdout, []byte("cut")}),
+			want: "cut",
+			err:  io.ErrUnexpectedEOF,
+		},
+		{
+			name: "cut in the padding",
+			data: records(fcgiRecord{fcgiStdout, []byte("cut")})[:14],
+			want: "cut",
+			err:  io.ErrUnexpectedEOF,
+		},
+		{
+			name: "cut in the content",
+			data: records(fcgiRecord{fcgiStdout, []byte("cut short")})[:12],
+			want: "cut ",
+			err:  io.ErrUnexpectedEOF,
+		},
+		{
+			name: "cut in the header",
+			data: records(fcgiRecord{fcgiStdout, []byte("ok")}, end)[:20],
+			want: "ok",
+			err:  io.ErrUnexpectedEOF,
+		},
+		{
+			name: "cut in stderr",
+			data: records(fcgiRecord{fcgiStderr, []byte("oops")})[:10],
+			want: "",
+			err:  io.ErrUnexpectedEOF,
+		},
+	}
+	defer log.SetFlags(log.Flags())
+	defer log.SetOutput(os.Stderr)
+	log.SetFlags(0)
+	for _, tt := range tests {
+		logs := &bytes.Buffer{}
+		log.SetOutput(logs)
+		f := &fcgiResponse{r: bufio.NewReader(bytes.NewReader(tt.data)), name: "script.php"}
+		got, err := io.ReadAll(f)
+		if string(got) != tt.want || err != tt.err {
+			t.Errorf("%s: read %q, %v, want %q, %v", tt.name, got, err, tt.want, tt.err)
+		}
+		if logs.String() != tt.stderr {
+			t.Errorf("%s: logged %q, want %q", tt.name, logs, tt.stderr)
+		}
+	}
+}
+
+func TestFCGIResponseBadVersion(t *testing.T) {
+	data := records(fcgiRecord{fcgiStdout, []byte("x")})
+	data[0] = 2
+	f := &fcgiResponse{r: bufio.NewReader(bytes.NewReader(data))}
+	if _, err := io.ReadAll(f); err == nil {
+		t.Error("record of version 2 read")
+	}
+}
+
+// TestFastCGIRequest runs a request against a responder that keeps what it
+// was sent for checking.
+func TestFastCGIRequest(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ln.Close()
+	sent := make(chan []byte, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// the request ends with an empty stdin record
+		var data []byte
+		buf := make([]byte, 4096)
+		for !bytes.HasSuffix(data, []byte{1, fcgiStdin, 0, 1, 0, 0, 0, 0}) {
+			n, err := conn.Read(buf)
+			if err != nil {
+				break
+			}
+			data = append(data, buf[:n]...)
+		}
+		sent <- data
+		conn.Write(records(
+			fcgiRecord{fcgiStdout, []byte("Content-Type: text/plain\r\n\r\n")},
+			fcgiRecord{fcgiStdout, []byte("done")},
+			fcgiRecord{fcgiEndRequest, make([]byte, 8)}))
+	}()
+
+	body := strings.Repeat("b", fcgiMaxContent+10)
+	long := strings.Repeat("v", 200)
+	out, err := fastCGIRequest(context.Background(), ln.Addr().String(),
+		[]string{"REQUEST_METHOD=POST", "LONG=" + long}, strings.NewReader(body), "app.php")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := io.ReadAll(out)
+	out.Close()
+	if err != nil || string(resp) != "Content-Type: text/plain\r\n\r\ndone" {
+		t.Errorf("response %q, %v", resp, err)
+	}
+
+	recs := readRecords(t, <-sent)
+	var types []byte
+	var params, stdin []byte
+	for _, rec := range recs {
+		if len(types) == 0 || types[len(types)-1] != rec.typ {
+			types = append(types, rec.typ)
+		}
+		switch rec.typ {
+		case fcgiParams:
+			params = append(params, rec.content...)
+		case fcgiStdin:
+			stdin = append(stdin, rec.content...)
+		}
+	}
+	if want := []byte{fcgiBeginRequest, fcgiParams, fcgiStdin}; !bytes.Equal(types, want) {
+		t.Errorf("record types %v, want %v", types, want)
+	}
+	if !bytes.Equal(recs[0].content, []byte{0, fcgiResponder, 0, 0, 0, 0, 0, 0}) {
+		t.Errorf("begin request % x", recs[0].content)
+	}
+	wantParams := append([]byte{14, 4}, "REQUEST_METHODPOST"...)
+	wantParams = append(append(wantParams, 4, 0x80, 0, 0, 200), "LONG"+long...)
+	if !bytes.Equal(params, wantParams) {
+		t.Errorf("params % x, want % x", params, wantParams)
+	}
+	if string(stdin) != body {
+		t.Errorf("stdin of %d bytes, want %d", len(stdin), len(body))
+	}
+}
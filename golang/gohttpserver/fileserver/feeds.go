@@ -0,0 +1,157 @@
+package fileserver
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"time"
+)
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate,omitempty"`
+	Creator     string `xml:"dc:creator,omitempty"`
+	Description string `xml:"description"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Published string      `xml:"published,omitempty"`
+	Author    *atomAuthor `xml:"author"`
+	Link      atomLink    `xml:"link"`
+	Summary   atomText    `xml:"summary"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// baseURL is the URL of the site, for the absolute links feeds and
+// sitemaps need: WithBaseURL, or else scheme and host of the request.
+func (s *Server) baseURL(req *http.Request) string {
+	if s.publicURL != "" {
+		return s.publicURL
+	}
+	scheme := "http"
+	if req.TLS != nil || req.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + req.Host
+}
+
+// articleTime is the date of the article, or its file's mtime when undated.
+func articleTime(a *article) time.Time {
+	if !a.meta.date.IsZero() {
+		return a.meta.date
+	}
+	return a.mtime
+}
+
+// writeFeed answers ?feed=rss or ?feed=atom for a list of articles sorted
+// newest first. link is the URL path of the page the feed belongs to.
+func (s *Server) writeFeed(rw http.ResponseWriter, req *http.Request, kind, title, description, link string, list []*article) {
+	base := s.baseURL(req)
+	updated := time.Time{}
+	if len(list) != 0 {
+		updated = articleTime(list[0])
+	}
+
+	var feed interface{}
+	switch kind {
+	case "rss":
+		ch := rssChannel{Title: title, Link: base + link, Description: description}
+		if !updated.IsZero() {
+			ch.LastBuildDate = updated.Format(time.RFC1123Z)
+		}
+		for _, a := range list {
+			item := rssItem{
+				Title:       articleTitle(a),
+				Link:        base + a.url,
+				GUID:        base + a.url,
+				Creator:     a.meta.author,
+				Description: a.excerpt,
+			}
+			if t := articleTime(a); !t.IsZero() {
+				item.PubDate = t.Format(time.RFC1123Z)
+			}
+			ch.Items = append(ch.Items, item)
+		}
+		feed = rssFeed{Version: "2.0", DC: "http://purl.org/dc/elements/1.1/", Channel: ch}
+		rw.Header().Set("content-type", "application/rss+xml; charset=utf-8")
+	case "atom":
+		f := atomFeed{
+			ID:      base + link,
+			Title:   title,
+			Updated: updated.Format(time.RFC3339),
+			Links: []atomLink{
+				{Href: base + req.URL.RequestURI(), Rel: "self"},
+				{Href: base + link, Rel: "alternate"},
+			},
+		}
+		for _, a := range list {
+			t := articleTime(a)
+			e := atomEntry{
+				ID:      base + a.url,
+				Title:   articleTitle(a),
+				Updated: t.Format(time.RFC3339),
+				Link:    atomLink{Href: base + a.url},
+				Summary: atomText{"html", a.excerpt},
+			}
+			if !a.meta.date.IsZero() {
+				e.Published = a.meta.date.Format(time.RFC3339)
+			}
+			if a.meta.author != "" {
+				e.Author = &atomAuthor{a.meta.author}
+			}
+			f.Entries = append(f.Entries, e)
+		}
+		feed = f
+		rw.Header().Set("content-type", "application/atom+xml; charset=utf-8")
+	default:
+		s.httpError(rw, req, http.StatusNotFound, nil)
+		return
+	}
+
+	io.WriteString(rw, xml.Header)
+	enc := xml.NewEncoder(rw)
+	enc.Indent("", "  ")
+	enc.Encode(feed)
+}
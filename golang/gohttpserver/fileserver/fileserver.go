@@ -0,0 +1,345 @@
+// Package fileserver serves a file tree over HTTP the way gohttpserver
+// does: directory listings, markdown rendered to pages, blogs, tags, feeds,
+// comments and the files themselves.
+//
+//	srv, err := fileserver.New(fileserver.WithDir("/srv/www"), fileserver.WithTheme("auto"))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	http.Handle("/", srv)
+//
+// Zip files and tarballs in the tree can be browsed like directories, as
+// /releases/app.zip/!/bin/tool, without extracting them.
+//
+// A Server can serve any fs.FS. Watching for changes, live reload and
+// comments, which write to the tree, need the tree to be a directory given
+// with WithDir.
+package fileserver
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yuin/goldmark"
+)
+
+// Server is the http.Handler of one file tree. Names of files within the
+// tree are fs.FS names, "." being the root.
+type Server struct {
+	fsys fs.FS
+	dir  string // absolute OS directory behind fsys, "" if there is none
+
+	// layers are the trees merged into fsys, the root first. With
+	// more than one, fsys is their overlay.
+	layers     []layer
+	overlay    overlayFS
+	showLayers bool
+
+	templates     fs.FS
+	templateDir   string
+	hidden        []ignoreRule
+	hideDotfiles  bool
+	renderers     map[string]Renderer
+	theme         string
+	highlight     string
+	highlightDark string
+	index         []string
+	sanitize      string
+	cacheSize     int64
+	cacheMaxFile  int64
+	dev           bool
+	commentsToken string
+	hsts          int
+	publicURL     string
+
+	// cgiDirs, cgiExts and fastCGI pick the scripts to run, see WithCGI
+	// and WithFastCGI. cgiSlots caps how many run at once.
+	cgiDirs    []string
+	cgiExts    []string
+	fastCGI    map[string]string
+	cgiTimeout time.Duration
+	cgiMax     int
+	cgiSlots   chan struct{}
+
+	mux     *http.ServeMux
+	handler http.Handler
+	md      goldmark.Markdown
+	css     map[string]string
+	cache   *lruCache
+	tags    tagIndex
+
+	// unsubscribe stops the change notifications, see Close.
+	unsubscribe func()
+
+	// blogs caches the sorted posts of every blog directory until
+	// something below it changes.
+	blogs struct {
+		sync.Mutex
+		m map[string][]*article
+	}
+	dirConfigs struct {
+		sync.Mutex
+		m map[string]*dirConfig
+	}
+	ignores struct {
+		sync.Mutex
+		m map[string]*dirIgnore
+	}
+	// sitemap caches the url paths and mtimes of everything indexable,
+	// until anything in the tree changes.
+	sitemap struct {
+		sync.Mutex
+		valid bool
+		urls  []sitemapURL
+	}
+	// userTemplates caches parsed templates until a file next to them
+	// changes. Failed parses are cached as nil.
+	userTemplates struct {
+		sync.Mutex
+		m map[templateKey]*template.Template
+	}
+	// threads serializes reads and writes of the comment files.
+	threads sync.Mutex
+	// commentLimiter remembers when each address posted in the last hour.
+	commentLimiter struct {
+		sync.Mutex
+		m map[string][]time.Time
+	}
+}
+
+// layer is one tree of an overlay, named for the listing markers.
+type layer struct {
+	name string
+	fsys fs.FS
+	dir  string
+}
+
+// errReadOnly is returned when writing to a tree that is no directory.
+var errReadOnly = errors.New("file system is read-only")
+
+// New returns a Server for the current directory, changed by opts.
+func New(opts ...Option) (*Server, error) {
+	s := &Server{
+		theme:         "light",
+		highlight:     "github",
+		highlightDark: "monokai",
+		index:         []string{"index.html", "index.htm"},
+		sanitize:      "none",
+		cacheSize:     64 << 20,
+		cacheMaxFile:  256 << 10,
+		hsts:          31536000,
+		renderers:     map[string]Renderer{},
+		fastCGI:       map[string]string{},
+		cgiTimeout:    30 * time.Second,
+		cgiMax:        16,
+		css: map[string]string{
+			"/md.css": mdCss,
+			"/fa.css": faCss,
+		},
+	}
+	WithDir(".")(s)
+	for _, opt := range opts {
+		opt(s)
+	}
+	top := layer{"root", s.fsys, s.dir}
+	if s.dir != "" {
+		top.name = filepath.Base(s.dir)
+	}
+	s.layers = append([]layer{top}, s.layers...)
+	if len(s.layers) > 1 {
+		for _, l := range s.layers {
+			s.overlay = append(s.overlay, l.fsys)
+		}
+		s.fsys = s.overlay
+	}
+	s.blogs.m = map[string][]*article{}
+	s.dirConfigs.m = map[string]*dirConfig{}
+	s.ignores.m = map[string]*dirIgnore{}
+	s.userTemplates.m = map[templateKey]*template.Template{}
+	s.commentLimiter.m = map[string][]time.Time{}
+	if s.publicURL != "" {
+		if u, err := url.Parse(s.publicURL); err != nil || u.Host == "" || u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("bad base URL %q", s.publicURL)
+		}
+	}
+	if s.cgiTimeout <= 0 || s.cgiMax < 1 {
+		return nil, fmt.Errorf("bad CGI limits: %v timeout, %d scripts at once", s.cgiTimeout, s.cgiMax)
+	}
+	s.cgiSlots = make(chan struct{}, s.cgiMax)
+
+	if err := s.initMarkdown(); err != nil {
+		return nil, err
+	}
+	if err := s.initThemes(); err != nil {
+		return nil, err
+	}
+	s.cache = newLRUCache(s.cacheSize)
+	if s.cache != nil {
+		s.cache.watch = s.watch
+	}
+	s.unsubscribe = watcher.subscribe(s.changed)
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("/", s.rootHandler)
+	s.mux.HandleFunc("/_tags/", s.tagsHandler)
+	s.mux.HandleFunc("/_comments/", s.commentsHandler)
+	if s.dev {
+		s.mux.HandleFunc("/_livereload", s.liveReloadHandler)
+	}
+	s.handler = withRequestID(s.withSecurityHeaders(s.withRecovery(s.mux)))
+	return s, nil
+}
+
+// ServeHTTP serves the file, page or listing behind req.URL.Path.
+func (s *Server) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	s.handler.ServeHTTP(rw, req)
+}
+
+// Close stops the Server from following changes to its tree, for programs
+// that create Servers and drop them again. It does not wait for requests
+// still being served.
+func (s *Server) Close() error {
+	s.unsubscribe()
+	return nil
+}
+
+// CacheStats reports the counters of the in-memory cache.
+func (s *Server) CacheStats() map[string]int64 {
+	return s.cache.stats()
+}
+
+// fsName is the name of the file behind a URL path.
+func fsName(upath string) string {
+	name := strings.TrimPrefix(path.Clean("/"+upath), "/")
+	if name == "" {
+		return "."
+	}
+	return name
+}
+
+// osPath is the OS path of name in the root, "" when the root is no
+// directory.
+func (s *Server) osPath(name string) string {
+	if s.dir == "" {
+		return ""
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(name))
+}
+
+// realPath returns the OS path of name, and of the root of the layer it
+// comes from, or "" if that layer is no directory.
+func (s *Server) realPath(name string) (root, fpath string) {
+	i := 0
+	if s.overlay != nil {
+		var err error
+		if i, _, err = s.overlay.layer("stat", name); err != nil {
+			return "", ""
+		}
+	}
+	if s.layers[i].dir == "" {
+		return "", ""
+	}
+	return s.layers[i].dir, filepath.Join(s.layers[i].dir, filepath.FromSlash(name))
+}
+
+// stat is fs.Stat of the tree.
+func (s *Server) stat(name string) (os.FileInfo, error) {
+	return fs.Stat(s.fsys, name)
+}
+
+// readDir lists the directory name like ioutil.ReadDir.
+func (s *Server) readDir(name string) ([]os.FileInfo, error) {
+	entries, err := fs.ReadDir(s.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]os.FileInfo, 0, len(entries))
+	for _, e := range entries {
+		finfo, err := e.Info()
+		if err != nil {
+			continue
+		}
+		list = append(list, finfo)
+	}
+	return list, nil
+}
+
+// writeFile replaces name through a rename, so readers never see a
+// half-written file.
+func (s *Server) writeFile(name string, data []byte) error {
+	if s.dir == "" {
+		return errReadOnly
+	}
+	fpath := s.osPath(name)
+	if err := os.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+		return err
+	}
+	tmp := fpath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, fpath)
+}
+
+// watch asks for change notifications of the directory name, in every
+// layer that has it.
+func (s *Server) watch(name string) {
+	for _, l := range s.layers {
+		if l.dir == "" {
+			continue
+		}
+		dir := filepath.Join(l.dir, filepath.FromSlash(name))
+		if finfo, err := os.Stat(dir); err == nil && finfo.IsDir() {
+			watcher.add(dir)
+		}
+	}
+}
+
+// nameOf returns the name of the OS path fpath in the layer it lies in.
+func (s *Server) nameOf(fpath string) (string, bool) {
+	for _, l := range s.layers {
+		if l.dir == "" {
+			continue
+		}
+		rel, err := filepath.Rel(l.dir, fpath)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(rel), true
+		}
+	}
+	return "", false
+}
+
+// changed invalidates what was built from the OS path fpath.
+func (s *Server) changed(fpath string) {
+	if s.templateDir != "" {
+		if rel, err := filepath.Rel(s.templateDir, fpath); err == nil && !strings.HasPrefix(rel, "..") {
+			s.invalidateTemplates(templateKey{false, filepath.ToSlash(rel)})
+		}
+	}
+	name, ok := s.nameOf(fpath)
+	if !ok {
+		return
+	}
+	s.invalidateIgnores(name)
+	s.cache.invalidate(name)
+	s.tags.invalidate(s, name)
+	s.invalidateBlogs(name)
+	s.invalidateDirConfig(name)
+	s.invalidateSitemap(name)
+	s.invalidateTemplates(templateKey{true, name})
+}
+
+// rendered reports whether name is rendered to a page rather than served.
+func (s *Server) rendered(name string) bool {
+	return s.renderer(name) != nil
+}
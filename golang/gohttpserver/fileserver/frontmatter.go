@@ -0,0 +1,199 @@
+package fileserver
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// frontMatter is the metadata block at the top of a markdown file. Three
+// forms are understood:
+//
+//	---              +++              ---title: legacy
+//	title: yaml      title = "toml"   ---date: 2016-01-02
+//	---              +++
+//
+// params holds every field, including the well-known ones, for templates.
+type frontMatter struct {
+	title   string
+	date    time.Time
+	rawDate string
+	author  string
+	tags    []string
+	params  map[string]interface{}
+}
+
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02 15:04:05",
+	"2006/01/02",
+	"2006.01.02",
+}
+
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", s)
+}
+
+// dateString formats the date for display, dropping a midnight time of day.
+func (fm *frontMatter) dateString() string {
+	if fm.date.IsZero() {
+		return fm.rawDate
+	}
+	if h, m, s := fm.date.Clock(); h == 0 && m == 0 && s == 0 {
+		return fm.date.Format("2006-01-02")
+	}
+	return fm.date.Format("2006-01-02 15:04")
+}
+
+func (s *Server) readFrontMatter(name string) (*frontMatter, error) {
+	text, err := fs.ReadFile(s.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	meta, _, err := splitFrontMatter(text)
+	return meta, err
+}
+
+// noindex reports whether the page asked to stay out of search engines.
+func (fm *frontMatter) noindex() bool {
+	return fm != nil && stringParam(fm.params["noindex"]) == "true"
+}
+
+// draft reports whether the page is kept out of blogs, tags and feeds.
+func (fm *frontMatter) draft() bool {
+	return fm != nil && stringParam(fm.params["draft"]) == "true"
+}
+
+// splitFrontMatter separates the metadata block from the markdown body.
+func splitFrontMatter(text []byte) (*frontMatter, []byte, error) {
+	fm := &frontMatter{params: map[string]interface{}{}}
+	first, rest := cutLine(text)
+	switch strings.TrimSpace(string(first)) {
+	case "---":
+		block, body, ok := cutBlock(rest, "---", "...")
+		if !ok {
+			return fm, text, nil
+		}
+		if err := yaml.Unmarshal(block, &fm.params); err != nil {
+			return fm, body, fmt.Errorf("yaml front matter: %v", err)
+		}
+		if fm.params == nil {
+			fm.params = map[string]interface{}{}
+		}
+		return fm, body, fm.fill()
+	case "+++":
+		block, body, ok := cutBlock(rest, "+++")
+		if !ok {
+			return fm, text, nil
+		}
+		if _, err := toml.Decode(string(block), &fm.params); err != nil {
+			return fm, body, fmt.Errorf("toml front matter: %v", err)
+		}
+		return fm, body, fm.fill()
+	}
+
+	body := text
+	for bytes.HasPrefix(body, []byte("---")) {
+		line, next := cutLine(body)
+		i := bytes.IndexByte(line, ':')
+		if i < 0 {
+			break
+		}
+		key := strings.TrimSpace(string(line[3:i]))
+		value := strings.TrimSpace(string(line[i+1:]))
+		if key == "tags" {
+			fm.params[key] = strings.Split(value, ",")
+		} else {
+			fm.params[key] = value
+		}
+		body = next
+	}
+	return fm, body, fm.fill()
+}
+
+// fill lifts the well-known fields out of params.
+func (fm *frontMatter) fill() error {
+	fm.title = stringParam(fm.params["title"])
+	fm.author = stringParam(fm.params["author"])
+
+	switch v := fm.params["tags"].(type) {
+	case string:
+		fm.tags = strings.Split(v, ",")
+	case []string:
+		fm.tags = v
+	case []interface{}:
+		for _, t := range v {
+			fm.tags = append(fm.tags, stringParam(t))
+		}
+	}
+	var tags []string
+	for _, t := range fm.tags {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	fm.tags = tags
+
+	switch v := fm.params["date"].(type) {
+	case nil:
+	case time.Time:
+		fm.date = v
+	default:
+		fm.rawDate = stringParam(v)
+		t, err := parseDate(fm.rawDate)
+		if err != nil {
+			return err
+		}
+		fm.date = t
+		fm.params["date"] = t
+	}
+	return nil
+}
+
+func stringParam(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func cutLine(text []byte) (line, rest []byte) {
+	if i := bytes.IndexByte(text, '\n'); i >= 0 {
+		return text[:i+1], text[i+1:]
+	}
+	return text, nil
+}
+
+// cutBlock returns everything up to the first line equal to one of the
+// delimiters, and the text after that line.
+func cutBlock(text []byte, delims ...string) (block, rest []byte, ok bool) {
+	for pos := 0; pos < len(text); {
+		line, next := cutLine(text[pos:])
+		l := strings.TrimSpace(string(line))
+		for _, d := range delims {
+			if l == d {
+				return text[:pos], next, true
+			}
+		}
+		pos += len(line)
+	}
+	return nil, text, false
+}
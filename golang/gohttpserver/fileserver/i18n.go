@@ -0,0 +1,118 @@
+package fileserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// catalog holds the messages of one language. listing fills the
+// loadTimeData of the chrome listing page.
+type catalog struct {
+	lang    string
+	listing map[string]string
+	units   [5]string
+	date    string
+}
+
+var catalogs = map[string]*catalog{
+	"en": {
+		lang: "en",
+		listing: map[string]string{
+			"header":                     "Index of LOCATION",
+			"headerDateModified":         "Date Modified",
+			"headerName":                 "Name",
+			"headerSize":                 "Size",
+			"listingParsingErrorBoxText": "Oh, no! This server is sending data Google Chrome can't understand. Please <a href=\"http://code.google.com/p/chromium/issues/entry\">report a bug</a>, and include the <a href=\"LOCATION\">raw listing</a>.",
+			"parentDirText":              "[parent directory]",
+			"textdirection":              "ltr",
+		},
+		units: [5]string{"B", "KB", "MB", "GB", "TB"},
+		date:  "Jan 2, 2006 15:04:05",
+	},
+	"zh": {
+		lang: "zh",
+		listing: map[string]string{
+			"header":                     "LOCATION 的索引",
+			"headerDateModified":         "修改日期",
+			"headerName":                 "名称",
+			"headerSize":                 "大小",
+			"listingParsingErrorBoxText": "糟糕！Google Chrome无法解读服务器所发送的数据。请<a href=\"http://code.google.com/p/chromium/issues/entry\">报告错误</a>，并附上<a href=\"LOCATION\">原始列表</a>。",
+			"parentDirText":              "[上级目录]",
+			"textdirection":              "ltr",
+		},
+		units: [5]string{"字节", "KB", "MB", "GB", "TB"},
+		date:  "2006年1月2日 15:04:05",
+	},
+}
+
+const defaultLang = "en"
+
+// findCatalog matches a language tag such as zh-CN against the catalogs,
+// falling back from region to base language.
+func findCatalog(tag string) *catalog {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for tag != "" {
+		if c, ok := catalogs[tag]; ok {
+			return c
+		}
+		i := strings.LastIndexAny(tag, "-_")
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return nil
+}
+
+// negotiateLang picks the catalog for a page in dir: ?lang= first, then
+// the best Accept-Language match, then the lang of .serve.yml.
+func (s *Server) negotiateLang(req *http.Request, dir string) *catalog {
+	if c := findCatalog(req.FormValue("lang")); c != nil {
+		return c
+	}
+
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(req.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			q, _ = strconv.ParseFloat(v, 64)
+		}
+		if strings.TrimSpace(tag) != "" && q > 0 {
+			tags = append(tags, weighted{tag, q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+	for _, t := range tags {
+		if c := findCatalog(t.tag); c != nil {
+			return c
+		}
+	}
+
+	if c := findCatalog(s.dirConfigFor(dir).Lang); c != nil {
+		return c
+	}
+	return catalogs[defaultLang]
+}
+
+func (c *catalog) formatDate(t time.Time) string {
+	return t.Format(c.date)
+}
+
+// loadTimeData is the JSON the listing page's i18n template reads.
+func (c *catalog) loadTimeData() string {
+	data := map[string]string{"language": c.lang}
+	for k, v := range c.listing {
+		data[k] = v
+	}
+	b, _ := json.Marshal(data)
+	return string(b)
+}
@@ -0,0 +1,202 @@
+package fileserver
+
+import (
+	"bufio"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const ignoreName = ".serveignore"
+
+// controlFiles configure the serving of their directory and are never
+// served themselves.
+var controlFiles = []string{ignoreName, dirConfigName, ".hidden"}
+
+// ignoreRule is one line of a .serveignore, which takes gitignore syntax:
+// patterns without a slash match names at any depth below the file, others
+// paths relative to it; a trailing slash matches directories only, a
+// leading ! brings back what an earlier pattern hid, and later lines and
+// deeper files win.
+type ignoreRule struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// dirIgnore is what a directory contributes to the hiding of paths below it.
+type dirIgnore struct {
+	hidden bool
+	rules  []ignoreRule
+}
+
+// isHidden reports whether the directory dir holds a .hidden file.
+func (s *Server) isHidden(dir string) bool {
+	_, err := s.stat(path.Join(dir, ".hidden"))
+	return err == nil
+}
+
+// loadIgnore returns the rules of dir. Only real directories are cached,
+// names made up by clients or found inside archives have none.
+func (s *Server) loadIgnore(dir string) *dirIgnore {
+	s.ignores.Lock()
+	defer s.ignores.Unlock()
+	if d, ok := s.ignores.m[dir]; ok {
+		return d
+	}
+	if finfo, err := s.stat(dir); err != nil || !finfo.IsDir() {
+		return &dirIgnore{}
+	}
+	d := &dirIgnore{hidden: s.isHidden(dir)}
+	if dir == "." {
+		d.rules = append(d.rules, s.hidden...)
+	}
+	if f, err := s.fsys.Open(path.Join(dir, ignoreName)); err == nil {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if rule, ok := parseIgnoreRule(scanner.Text()); ok {
+				d.rules = append(d.rules, rule)
+			}
+		}
+		f.Close()
+	}
+	s.watch(dir)
+	s.ignores.m[dir] = d
+	return d
+}
+
+func parseIgnoreRule(line string) (ignoreRule, bool) {
+	var rule ignoreRule
+	line = strings.TrimRight(line, "\r")
+	if !strings.HasSuffix(line, "\\ ") {
+		line = strings.TrimRight(line, " \t")
+	}
+	if line == "" || line[0] == '#' {
+		return rule, false
+	}
+	if line[0] == '!' {
+		rule.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return rule, false
+	}
+	prefix := "^(?:.*/)?"
+	if strings.Contains(line, "/") {
+		prefix = "^"
+		line = strings.TrimPrefix(line, "/")
+	}
+
+	var re strings.Builder
+	re.WriteString(prefix)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case strings.HasPrefix(line[i:], "**/"):
+			re.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(line[i:], "/**") && i+3 == len(line):
+			re.WriteString("/.*")
+			i += 2
+		case strings.HasPrefix(line[i:], "**") && i+2 == len(line):
+			re.WriteString(".*")
+			i++
+		case c == '*':
+			re.WriteString("[^/]*")
+		case c == '?':
+			re.WriteString("[^/]")
+		case c == '\\' && i+1 < len(line):
+			i++
+			re.WriteString(regexp.QuoteMeta(line[i : i+1]))
+		case c == '[':
+			j := strings.IndexByte(line[i+1:], ']')
+			if j < 0 {
+				re.WriteString(`\[`)
+				break
+			}
+			class := line[i+1 : i+1+j]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += j + 1
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	re.WriteString("$")
+	var err error
+	if rule.re, err = regexp.Compile(re.String()); err != nil {
+		return rule, false
+	}
+	return rule, true
+}
+
+// ignored reports whether name, or any directory above it, is hidden by a
+// .hidden file, a .serveignore, WithHidden, WithHideDotfiles or being a
+// comments directory. Invalid names and control files are always ignored. Every way of
+// reaching files goes through it, so hidden content never shows up.
+func (s *Server) ignored(name string, isDir bool) bool {
+	if !fs.ValidPath(name) {
+		return true
+	}
+	if name == "." {
+		return false
+	}
+
+	parts := strings.Split(name, "/")
+	dirs := []*dirIgnore{s.loadIgnore(".")}
+	dir := "."
+	for i, part := range parts {
+		dir = path.Join(dir, part)
+		last := i == len(parts)-1
+		if part == commentsDir || s.hideDotfiles && strings.HasPrefix(part, ".") {
+			return true
+		}
+		if last && !isDir {
+			for _, c := range controlFiles {
+				if part == c {
+					return true
+				}
+			}
+		}
+		hide := false
+		for j, d := range dirs {
+			// dirs[j] is the directory holding parts[j], whose
+			// patterns match relative to it
+			sub := strings.Join(parts[j:i+1], "/")
+			for _, rule := range d.rules {
+				if (!rule.dirOnly || !last || isDir) && rule.re.MatchString(sub) {
+					hide = !rule.negate
+				}
+			}
+		}
+		if hide {
+			return true
+		}
+		if !last || isDir {
+			d := s.loadIgnore(dir)
+			if d.hidden {
+				return true
+			}
+			dirs = append(dirs, d)
+		}
+	}
+	return false
+}
+
+// invalidateIgnores forgets the rules once a .serveignore or .hidden
+// changes, since they apply to whole subtrees.
+func (s *Server) invalidateIgnores(name string) {
+	if base := path.Base(name); base != ignoreName && base != ".hidden" {
+		return
+	}
+	s.ignores.Lock()
+	s.ignores.m = map[string]*dirIgnore{}
+	s.ignores.Unlock()
+}
@@ -0,0 +1,153 @@
+package fileserver
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestIgnored(t *testing.T) {
+	fsys := fstest.MapFS{
+		".serveignore": {Data: []byte(`# a comment, not a pattern
+*.log
+!keep.log
+/build
+docs/*.tmp
+tmp/
+**/cache/**
+a?c
+[bc]at
+[!x]y
+\#literal
+` + "trailing\\ \n")},
+		".serve.yml":        {Data: []byte("theme: dark\n")},
+		"sub/.serveignore":  {Data: []byte("!debug.log\nsecret\n")},
+		"sub/debug.log":     {},
+		"hide/.hidden":      {},
+		"hide/file":         {},
+		".comments/a.json":  {},
+		"src/build/main.go": {},
+	}
+	s, err := New(WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	tests := []struct {
+		name  string
+		isDir bool
+		want  bool
+	}{
+		{".", true, false},
+		{"index.html", false, false},
+
+		// patterns without a slash match at any depth
+		{"app.log", false, true},
+		{"sub/deep/app.log", false, true},
+		// negation brings back what an earlier line hid
+		{"keep.log", false, false},
+		{"sub/keep.log", false, false},
+		// a deeper .serveignore wins over the root's
+		{"sub/debug.log", false, false},
+		{"sub/secret", false, true},
+		{"secret", false, false},
+
+		// a leading slash, or one inside, anchors to the .serveignore
+		{"build", true, true},
+		{"build/out.js", false, true},
+		{"src/build", true, false},
+		{"src/build/main.go", false, false},
+		{"docs/a.tmp", false, true},
+		{"docs/sub/a.tmp", false, false},
+		{"x/docs/a.tmp", false, false},
+
+		// a trailing slash matches directories only, and their contents
+		{"tmp", true, true},
+		{"tmp", false, false},
+		{"x/tmp/f", false, true},
+
+		// ** spans directories
+		{"cache", true, false},
+		{"cache/x", false, true},
+		{"a/cache/b/c", false, true},
+
+		{"abc", false, true},
+		{"abbc", false, false},
+		{"a/c", false, false},
+		{"cat", false, true},
+		{"rat", false, false},
+		{"ay", false, true},
+		{"xy", false, false},
+		{"#literal", false, true},
+		{"# a comment, not a pattern", false, false},
+		{"trailing ", false, true},
+
+		// .hidden hides its directory
+		{"hide", true, true},
+		{"hide/file", false, true},
+
+		// control files, comments and invalid names are never served
+		{".serveignore", false, true},
+		{".serve.yml", false, true},
+		{"sub/.serveignore", false, true},
+		{".comments", true, true},
+		{".comments/a.json", false, true},
+		{"../etc/passwd", false, true},
+		{"/etc/passwd", false, true},
+		{"a//b", false, true},
+	}
+	for _, tt := range tests {
+		if got := s.ignored(tt.name, tt.isDir); got != tt.want {
+			t.Errorf("ignored(%q, %v) = %v, want %v", tt.name, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestIgnoredOptions(t *testing.T) {
+	fsys := fstest.MapFS{
+		".serveignore": {Data: []byte("!public.key\n")},
+		"sub/x":        {},
+	}
+	s, err := New(WithFS(fsys), WithHidden("*.key", "/private/"), WithHideDotfiles(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	tests := []struct {
+		name  string
+		isDir bool
+		want  bool
+	}{
+		{"server.key", false, true},
+		{"sub/server.key", false, true},
+		// WithHidden leads the root's .serveignore, which can undo it
+		{"public.key", false, false},
+		{"private", true, true},
+		{"sub/private", true, false},
+		{".git", true, true},
+		{"sub/.env", false, true},
+		{"sub/x", false, false},
+	}
+	for _, tt := range tests {
+		if got := s.ignored(tt.name, tt.isDir); got != tt.want {
+			t.Errorf("ignored(%q, %v) = %v, want %v", tt.name, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestLoadIgnoreMissingDirs(t *testing.T) {
+	s, err := New(WithFS(fstest.MapFS{"a/b": {}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	s.ignored("made/up/dirs/x", false)
+	s.ignored("a/b/c", false)
+	for dir := range s.ignores.m {
+		if dir != "." && dir != "a" {
+			t.Errorf("rules of %q cached", dir)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package fileserver
+
+import (
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// indexFile returns the index file of dir, if it has one.
+func (s *Server) indexFile(dir string) (string, os.FileInfo) {
+	names := s.dirConfigFor(dir).Index
+	if names == nil {
+		names = s.index
+	}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || strings.ContainsAny(name, `/\`) {
+			continue
+		}
+		name = path.Join(dir, name)
+		if finfo, err := s.stat(name); err == nil && !finfo.IsDir() && !s.ignored(name, false) {
+			return name, finfo
+		}
+	}
+	return "", nil
+}
+
+// serveIndex serves the index file of a directory, redirecting to the
+// slashed URL first so the page's relative links resolve.
+func (s *Server) serveIndex(rw http.ResponseWriter, req *http.Request, name string, finfo os.FileInfo) {
+	if !strings.HasSuffix(req.URL.Path, "/") {
+		target := req.URL.EscapedPath() + "/"
+		if req.URL.RawQuery != "" {
+			target += "?" + req.URL.RawQuery
+		}
+		http.Redirect(rw, req, target, http.StatusMovedPermanently)
+		return
+	}
+	if s.rendered(name) {
+		s.markdownHandler(rw, req, name, finfo)
+	} else {
+		s.serveFile(rw, req, name, finfo)
+	}
+}
+
+// spaFallback answers a missing path below a directory configured with
+// "spa: true" with that directory's index file, so client-side routes of
+// single page apps load. Paths that look like assets, having an extension
+// other than .html, still get a 404. It reports whether it served.
+func (s *Server) spaFallback(rw http.ResponseWriter, req *http.Request, name string) bool {
+	if req.Method != "GET" && req.Method != "HEAD" {
+		return false
+	}
+	if ext := path.Ext(req.URL.Path); ext != "" && ext != ".html" && ext != ".htm" {
+		return false
+	}
+	dir := s.existingDir(path.Dir(name))
+	if s.ignored(dir, true) {
+		return false
+	}
+	spa := s.dirConfigFor(dir).spaRoot
+	if spa == "" {
+		return false
+	}
+	index, finfo := s.indexFile(spa)
+	if index == "" {
+		return false
+	}
+	if s.rendered(index) {
+		s.markdownHandler(rw, req, index, finfo)
+	} else {
+		s.serveFile(rw, req, index, finfo)
+	}
+	return true
+}
+
+// existingDir returns the nearest directory at or above name that exists,
+// the root at the latest.
+func (s *Server) existingDir(name string) string {
+	for ; name != "."; name = path.Dir(name) {
+		if finfo, err := s.stat(name); err == nil && finfo.IsDir() {
+			return name
+		}
+	}
+	return name
+}
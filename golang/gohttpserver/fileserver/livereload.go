@@ -0,0 +1,95 @@
+package fileserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// livereloadScript is injected into markdown pages and listings in dev mode.
+var livereloadScript = `<script>
+(function() {
+  if (!window.EventSource) return;
+  var es = new EventSource("/_livereload?path=" + encodeURIComponent(location.pathname));
+  es.addEventListener("reload", function() { location.reload(); });
+})();
+</script>`
+
+// liveReloadHandler keeps a Server-Sent Events stream open and emits a
+// "reload" event whenever the file or directory behind path changes.
+// Ignored paths get a 404, and changes to ignored files go unreported.
+func (s *Server) liveReloadHandler(rw http.ResponseWriter, req *http.Request) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		s.httpError(rw, req, http.StatusInternalServerError, fmt.Errorf("streaming unsupported"))
+		return
+	}
+	upath, err := url.PathUnescape(req.FormValue("path"))
+	if err != nil {
+		s.httpError(rw, req, http.StatusBadRequest, nil)
+		return
+	}
+	name := fsName(upath)
+	finfo, err := s.stat(name)
+	if err != nil || s.dir == "" || s.ignored(name, finfo.IsDir()) {
+		s.httpError(rw, req, http.StatusNotFound, nil)
+		return
+	}
+
+	dir := name
+	if !finfo.IsDir() {
+		dir = path.Dir(name)
+	}
+	changed := make(chan string, 1)
+	cancel := watcher.subscribe(func(fpath string) {
+		n, ok := s.nameOf(fpath)
+		if !ok || n != name && (!finfo.IsDir() || path.Dir(n) != name) {
+			return
+		}
+		// hidden files must not give themselves away by their events
+		isDir := false
+		if info, err := s.stat(n); err == nil {
+			isDir = info.IsDir()
+		}
+		if !s.ignored(n, isDir) {
+			select {
+			case changed <- n:
+			default:
+			}
+		}
+	})
+	defer cancel()
+	s.watch(dir)
+
+	rw.Header().Set("content-type", "text/event-stream")
+	rw.Header().Set("cache-control", "no-cache")
+	fmt.Fprint(rw, "retry: 1000\n\n")
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(30 * time.Second)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(rw, ": ping\n\n")
+		case name := <-changed:
+			// editors tend to write in bursts, let them settle first
+			time.Sleep(100 * time.Millisecond)
+			select {
+			case <-changed:
+			default:
+			}
+			rel := name
+			if dir != "." {
+				rel = strings.TrimPrefix(name, dir+"/")
+			}
+			fmt.Fprintf(rw, "event: reload\ndata: %s\n\n", rel)
+		}
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,160 @@
+package fileserver
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/yuin/goldmark"
+	emoji "github.com/yuin/goldmark-emoji"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	goldhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// highlightCSS is the stylesheet of the chroma style name.
+func highlightCSS(name string) (string, error) {
+	style, ok := styles.Registry[name]
+	if !ok {
+		return "", fmt.Errorf("unknown highlight theme %q, available: %s",
+			name, strings.Join(styles.Names(), ", "))
+	}
+
+	css := &bytes.Buffer{}
+	if err := chromahtml.New(chromahtml.WithClasses(true)).WriteCSS(css, style); err != nil {
+		return "", err
+	}
+	// md.css paints every pre light grey, let the theme win
+	if bg := style.Get(chroma.Background); bg.Background.IsSet() {
+		fmt.Fprintf(css, ".markdown-body pre.chroma { background-color: %s; }\n", bg.Background)
+	}
+	return css.String(), nil
+}
+
+// initMarkdown builds the CommonMark/GFM engine and publishes the css of
+// the selected highlighting theme as /hl.css.
+func (s *Server) initMarkdown() error {
+	css, err := highlightCSS(s.highlight)
+	if err != nil {
+		return err
+	}
+	s.css["/hl.css"] = css
+
+	s.md = goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			extension.Footnote,
+			extension.DefinitionList,
+			extension.Typographer,
+			emoji.Emoji,
+			mathExtension{},
+			highlighting.NewHighlighting(
+				highlighting.WithStyle(s.highlight),
+				highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+			),
+		),
+		goldmark.WithParserOptions(
+			parser.WithAutoHeadingID(),
+			parser.WithAttribute(),
+			parser.WithASTTransformers(util.Prioritized(headingAnchors{}, 1000)),
+		),
+		goldmark.WithRendererOptions(
+			goldhtml.WithUnsafe(),
+			goldhtml.WithXHTML(),
+			goldhtml.WithHardWraps(),
+		),
+	)
+	return nil
+}
+
+// markdownToHTML renders src with a table of contents in front of it.
+func (s *Server) markdownToHTML(src []byte) ([]byte, error) {
+	doc := s.md.Parser().Parse(text.NewReader(src))
+	out := &bytes.Buffer{}
+	writeTOC(out, doc, src)
+	if err := s.md.Renderer().Render(out, src, doc); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func writeTOC(out *bytes.Buffer, doc ast.Node, src []byte) {
+	var levels []int
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		h, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		id, _ := h.AttributeString("id")
+		idb, _ := id.([]byte)
+
+		if len(levels) == 0 {
+			out.WriteString("<nav>\n")
+		}
+		switch {
+		case len(levels) == 0 || h.Level > levels[len(levels)-1]:
+			levels = append(levels, h.Level)
+			out.WriteString("<ul>\n")
+		default:
+			for len(levels) > 1 && h.Level < levels[len(levels)-1] {
+				levels = levels[:len(levels)-1]
+				out.WriteString("</li>\n</ul>\n")
+			}
+			out.WriteString("</li>\n")
+		}
+		title := &bytes.Buffer{}
+		for c := h.FirstChild(); c != nil; c = c.NextSibling() {
+			if c == h.FirstChild() && isAnchor(c) {
+				continue
+			}
+			title.Write(c.Text(src))
+		}
+		fmt.Fprintf(out, `<li><a href="#%s">%s</a>`, template.HTMLEscapeString(string(idb)),
+			template.HTMLEscapeString(title.String()))
+		return ast.WalkSkipChildren, nil
+	})
+	for range levels {
+		out.WriteString("</li>\n</ul>\n")
+	}
+	if len(levels) != 0 {
+		out.WriteString("</nav>\n\n")
+	}
+}
+
+// headingAnchors puts a self link in front of every heading, like GitHub.
+type headingAnchors struct{}
+
+func (headingAnchors) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		h, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		id, ok := h.AttributeString("id")
+		if !ok {
+			return ast.WalkSkipChildren, nil
+		}
+		link := ast.NewLink()
+		link.Destination = append([]byte("#"), id.([]byte)...)
+		link.SetAttributeString("class", []byte("anchor"))
+		icon := ast.NewString([]byte(`<i class="fa fa-link octicon-link"></i>`))
+		icon.SetCode(true)
+		link.AppendChild(link, icon)
+		h.InsertBefore(h, h.FirstChild(), link)
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+func isAnchor(n ast.Node) bool {
+	class, _ := n.AttributeString("class")
+	b, _ := class.([]byte)
+	return string(b) == "anchor"
+}
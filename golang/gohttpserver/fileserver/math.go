@@ -0,0 +1,212 @@
+package fileserver
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// mathExtension adds $inline$ and $$display$$ math to goldmark, rendered
+// on the server into MathML by latexToMathML.
+type mathExtension struct{}
+
+func (mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(mathBlockParser{}, 150)),
+		parser.WithInlineParsers(util.Prioritized(mathInlineParser{}, 150)),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(mathRenderer{}, 150)))
+}
+
+var kindMathInline = ast.NewNodeKind("MathInline")
+var kindMathBlock = ast.NewNodeKind("MathBlock")
+
+type mathInline struct {
+	ast.BaseInline
+	src     []byte
+	display bool
+}
+
+func (n *mathInline) Kind() ast.NodeKind { return kindMathInline }
+
+func (n *mathInline) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"src": string(n.src)}, nil)
+}
+
+type mathBlock struct {
+	ast.BaseBlock
+	closed bool
+}
+
+func (n *mathBlock) Kind() ast.NodeKind { return kindMathBlock }
+
+func (n *mathBlock) IsRaw() bool { return true }
+
+func (n *mathBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+type mathInlineParser struct{}
+
+func (mathInlineParser) Trigger() []byte { return []byte{'$'} }
+
+// Parse follows pandoc: the opening $ must be followed by a non-space and
+// the closing $ preceded by a non-space and not followed by a digit, so
+// "$5 and $10" stays text. $$...$$ inside a paragraph is display math and
+// may span lines, $...$ may not.
+func (mathInlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	delim := 1
+	if len(line) > 1 && line[1] == '$' {
+		delim = 2
+	}
+	if len(line) <= delim || line[delim] == ' ' || line[delim] == '\t' || line[delim] == '\n' {
+		return nil
+	}
+	l, pos := block.Position()
+	block.Advance(delim)
+	src := &bytes.Buffer{}
+	for {
+		line, _ := block.PeekLine()
+		if line == nil {
+			block.SetPosition(l, pos)
+			return nil
+		}
+		for i := 0; i < len(line); i++ {
+			switch line[i] {
+			case '\\':
+				i++
+				continue
+			case '$':
+			default:
+				continue
+			}
+			if delim == 2 && (i+1 >= len(line) || line[i+1] != '$') {
+				continue
+			}
+			if delim == 1 {
+				prev := byte(' ')
+				if i > 0 {
+					prev = line[i-1]
+				} else if src.Len() > 0 {
+					prev = src.Bytes()[src.Len()-1]
+				}
+				if prev == ' ' || prev == '\t' || prev == '\n' ||
+					i+1 < len(line) && line[i+1] >= '0' && line[i+1] <= '9' {
+					continue
+				}
+			}
+			src.Write(line[:i])
+			block.Advance(i + delim)
+			if src.Len() == 0 {
+				block.SetPosition(l, pos)
+				return nil
+			}
+			return &mathInline{src: src.Bytes(), display: delim == 2}
+		}
+		if delim == 1 {
+			block.SetPosition(l, pos) // $...$ stays on one line
+			return nil
+		}
+		src.Write(line)
+		block.AdvanceLine()
+	}
+}
+
+type mathBlockParser struct{}
+
+func (mathBlockParser) Trigger() []byte { return []byte{'$'} }
+
+func (mathBlockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], []byte("$$")) {
+		return nil, parser.NoChildren
+	}
+	node := &mathBlock{}
+	start := segment.Start + pos + 2
+	rest := line[pos+2:]
+	if i := bytes.Index(rest, []byte("$$")); i >= 0 {
+		if !util.IsBlank(rest[i+2:]) {
+			return nil, parser.NoChildren // "$$a$$ and more" is a paragraph
+		}
+		node.Lines().Append(text.NewSegment(start, start+i))
+		node.closed = true
+	} else if !util.IsBlank(rest) {
+		node.Lines().Append(text.NewSegment(start, segment.Stop))
+	}
+	reader.AdvanceToEOL()
+	return node, parser.NoChildren
+}
+
+func (mathBlockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*mathBlock)
+	if n.closed {
+		return parser.Close
+	}
+	line, segment := reader.PeekLine()
+	if i := bytes.Index(line, []byte("$$")); i >= 0 {
+		n.Lines().Append(text.NewSegment(segment.Start, segment.Start+i))
+		n.closed = true
+		reader.AdvanceToEOL()
+		return parser.Close
+	}
+	n.Lines().Append(segment)
+	reader.AdvanceToEOL()
+	return parser.Continue | parser.NoChildren
+}
+
+func (mathBlockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+func (mathBlockParser) CanInterruptParagraph() bool { return true }
+
+func (mathBlockParser) CanAcceptIndentedLine() bool { return false }
+
+type mathRenderer struct{}
+
+func (mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindMathInline, func(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			n := node.(*mathInline)
+			writeMath(w, string(n.src), n.display)
+		}
+		return ast.WalkSkipChildren, nil
+	})
+	reg.Register(kindMathBlock, func(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			src := &bytes.Buffer{}
+			lines := node.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				seg := lines.At(i)
+				src.Write(seg.Value(source))
+			}
+			w.WriteString("<p>")
+			writeMath(w, src.String(), true)
+			w.WriteString("</p>\n")
+		}
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// writeMath renders one formula, or its source marked up as an error so a
+// typo never takes the rest of the page with it.
+func writeMath(w util.BufWriter, src string, display bool) {
+	ml, err := latexToMathML(src, display)
+	if err == nil {
+		w.WriteString(ml)
+		return
+	}
+	delim := "$"
+	if display {
+		delim = "$$"
+	}
+	fmt.Fprintf(w, `<span class="math-error">%s%s%s <em>%s</em></span>`,
+		delim, template.HTMLEscapeString(src), delim, template.HTMLEscapeString(err.Error()))
+}
@@ -0,0 +1,284 @@
+package fileserver
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Option configures a Server, see New.
+type Option func(*Server)
+
+// Renderer turns the source of a file, front matter removed, into the HTML
+// of its page.
+type Renderer func(src []byte) ([]byte, error)
+
+// WithFS serves fsys, such as an embed.FS, an Archive or a subtree of
+// either taken with fs.Sub. Changes to it go unnoticed, and comments
+// cannot be posted.
+func WithFS(fsys fs.FS) Option {
+	return func(s *Server) {
+		s.fsys, s.dir = fsys, ""
+	}
+}
+
+// WithDir serves the OS directory dir, watching it for changes. It is the
+// default, with the current directory.
+func WithDir(dir string) Option {
+	return func(s *Server) {
+		s.dir, _ = filepath.Abs(dir)
+		s.fsys = os.DirFS(s.dir)
+	}
+}
+
+// WithLayerDir lays the OS directory dir beneath the root and the layers
+// added before it, watching it for changes. A name resolves to the first
+// of them that has it, starting with the root, and listings show the
+// entries of all of them. Writes, such as comments, only go to the root.
+func WithLayerDir(dir string) Option {
+	return func(s *Server) {
+		dir, _ = filepath.Abs(dir)
+		s.layers = append(s.layers, layer{filepath.Base(dir), os.DirFS(dir), dir})
+	}
+}
+
+// WithLayer is WithLayerDir of fsys, whose changes go unnoticed. name marks
+// its entries in listings.
+func WithLayer(name string, fsys fs.FS) Option {
+	return func(s *Server) {
+		s.layers = append(s.layers, layer{name, fsys, ""})
+	}
+}
+
+// WithLayerMarkers marks the entries of listings with the name of the
+// layer they come from, the root's being its directory name.
+func WithLayerMarkers(show bool) Option {
+	return func(s *Server) {
+		s.showLayers = show
+	}
+}
+
+// WithTemplates lets sites replace the built-in pages with their own
+// html/template files: fsys is searched for <kind>.html, and a .serve.yml
+// can pick other files for its directory and everything below it:
+//
+//	templates:
+//	  blog: .templates/blog.html
+//	  markdown: .templates/post.html
+//
+// Paths are relative to the directory of the .serve.yml, or to the root
+// when they start with a slash. All *.html files next to a template are
+// parsed with it, so they can be used as partials with
+// {{template "name.html" .}}. Templates are reloaded when edited; one that
+// fails to parse is logged and the built-in page is served instead.
+//
+// Every kind gets a map. Fields documented as HTML are template.HTML and
+// output as they are, everything else is escaped by html/template.
+// Inline scripts only run with nonce="{{.nonce}}".
+//
+// "markdown" renders markdown pages and the /_tags/ pages:
+//
+//	title       page title
+//	date        time.Time from the front matter, zero if unset
+//	params      every front matter field
+//	mdbody      rendered HTML
+//	articlemeta HTML of the links, date, author and tags above the article
+//	head        HTML for the <head>, such as feed links
+//	theme       HTML linking the theme stylesheets
+//	comments    HTML of the comments section, empty when off
+//	livereload  HTML of the script reloading the page in dev mode
+//	nonce       nonce of the Content-Security-Policy
+//
+// "blog" renders blog indexes and falls back to the markdown template. It
+// gets the same fields, mdbody being the built-in index, plus
+//
+//	posts       []BlogPost of the current page
+//	page, pages current page number and page count
+//
+// "listing" renders directory listings:
+//
+//	title       host and path
+//	path        URL path of the directory
+//	parent      whether there is a parent directory to link to
+//	entries     []ListEntry, directories first
+//	theme       as above
+//	lang        negotiated language, such as "en"
+//	text        map of the listing strings in that language
+//	readme      HTML of the directory's README, empty if there is none
+//	readmepos   "above" or "below" the listing
+//	livereload  as above
+func WithTemplates(fsys fs.FS) Option {
+	return func(s *Server) {
+		s.templates, s.templateDir = fsys, ""
+	}
+}
+
+// WithTemplateDir is WithTemplates of an OS directory, reloading templates
+// as they are edited.
+func WithTemplateDir(dir string) Option {
+	return func(s *Server) {
+		if dir == "" {
+			s.templates, s.templateDir = nil, ""
+			return
+		}
+		s.templateDir, _ = filepath.Abs(dir)
+		s.templates = os.DirFS(s.templateDir)
+	}
+}
+
+// WithHidden hides what the gitignore-style patterns match, as if they led
+// the .serveignore of the root.
+func WithHidden(patterns ...string) Option {
+	return func(s *Server) {
+		for _, p := range patterns {
+			if rule, ok := parseIgnoreRule(p); ok {
+				s.hidden = append(s.hidden, rule)
+			}
+		}
+	}
+}
+
+// WithHideDotfiles hides every file and directory whose name starts with
+// a dot, as if each .serveignore listed ".*".
+func WithHideDotfiles(hide bool) Option {
+	return func(s *Server) {
+		s.hideDotfiles = hide
+	}
+}
+
+// WithRenderer renders files with the extension ext, such as ".adoc", to
+// pages with r. It replaces the markdown renderer when ext is ".md".
+func WithRenderer(ext string, r Renderer) Option {
+	return func(s *Server) {
+		s.renderers[strings.ToLower(ext)] = r
+	}
+}
+
+// WithTheme sets the default theme: light, dark or auto. A .serve.yml can
+// choose another for its directory and below, and layer a stylesheet of
+// its own on top:
+//
+//	theme: auto
+//	css: /assets/site.css
+//
+// Themes are served as /theme-<name>.css and style listings and markdown
+// pages alike.
+func WithTheme(name string) Option {
+	return func(s *Server) {
+		s.theme = name
+	}
+}
+
+// WithHighlightThemes sets the chroma styles of fenced code blocks in the
+// light and the dark themes.
+func WithHighlightThemes(light, dark string) Option {
+	return func(s *Server) {
+		s.highlight, s.highlightDark = light, dark
+	}
+}
+
+// WithIndex names the files served for a directory instead of its
+// listing. A .serve.yml overrides them with index: [...], an empty list
+// bringing the listing back.
+func WithIndex(names ...string) Option {
+	return func(s *Server) {
+		s.index = names
+	}
+}
+
+// WithSanitize sets the default sanitize policy of rendered markdown: none
+// keeps raw HTML, ugc allows what user generated content safely can,
+// strict only what markdown itself produces. Front matter fields such as
+// the title, author and tags are always escaped, whatever the policy. A
+// .serve.yml picks the policy of its directory and below, and can widen
+// the allowlist:
+//
+//	sanitize:
+//	  policy: ugc
+//	  allow_elements: [details, summary]
+//	  allow_attributes:
+//	    open: [details]
+//	  allow_url_schemes: [mailto, ftp]
+func WithSanitize(policy string) Option {
+	return func(s *Server) {
+		s.sanitize = policy
+	}
+}
+
+// WithCache sets the size of the in-memory cache of small files and
+// rendered pages, 0 disabling it, and the largest file it keeps.
+func WithCache(size, maxFile int64) Option {
+	return func(s *Server) {
+		s.cacheSize, s.cacheMaxFile = size, maxFile
+	}
+}
+
+// WithDev reloads markdown pages and listings in the browser when their
+// files change. It needs WithDir.
+func WithDev(dev bool) Option {
+	return func(s *Server) {
+		s.dev = dev
+	}
+}
+
+// WithBaseURL sets the public URL of the site, such as
+// https://example.com, for the absolute links of feeds, sitemap.xml and
+// robots.txt. Without it they are made from the Host header of each
+// request, which clients choose.
+func WithBaseURL(u string) Option {
+	return func(s *Server) {
+		s.publicURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// WithCommentsToken sets the secret of the comment moderation page,
+// /_comments/moderate?token=...
+func WithCommentsToken(token string) Option {
+	return func(s *Server) {
+		s.commentsToken = token
+	}
+}
+
+// WithCGI runs the files below the directories dirs, and the files with
+// the extensions exts anywhere, such as ".cgi", as CGI scripts, see RFC
+// 3875. Scripts must be executable files of a directory tree; the URL path
+// may go on past them, as /cgi-bin/app.py/users/1, into their PATH_INFO.
+// Nothing is run unless asked for.
+func WithCGI(dirs, exts []string) Option {
+	return func(s *Server) {
+		for _, dir := range dirs {
+			s.cgiDirs = append(s.cgiDirs, fsName(dir))
+		}
+		for _, ext := range exts {
+			s.cgiExts = append(s.cgiExts, strings.ToLower(ext))
+		}
+	}
+}
+
+// WithFastCGI passes requests for files with the extension ext, such as
+// ".php", to the FastCGI responder at addr: host:port, or the path of a
+// unix socket.
+func WithFastCGI(ext, addr string) Option {
+	return func(s *Server) {
+		s.fastCGI[strings.ToLower(ext)] = addr
+	}
+}
+
+// WithCGILimits sets how long a script may take before the client gets a
+// 504, and how many may run at once before clients get a 503. They are
+// 30 seconds and 16 by default; New fails unless both are positive.
+func WithCGILimits(timeout time.Duration, maxConcurrent int) Option {
+	return func(s *Server) {
+		s.cgiTimeout, s.cgiMax = timeout, maxConcurrent
+	}
+}
+
+// WithHSTS sets the max-age in seconds of the Strict-Transport-Security
+// header sent over HTTPS, 0 leaving it out.
+func WithHSTS(maxAge int) Option {
+	return func(s *Server) {
+		s.hsts = maxAge
+	}
+}
@@ -0,0 +1,81 @@
+package fileserver
+
+import (
+	"io/fs"
+	"sort"
+)
+
+// OverlayFS merges layers into one tree. A name resolves to the first
+// layer that has it, and a directory lists the entries of every layer
+// holding it, upper entries hiding lower ones of the same name.
+func OverlayFS(layers ...fs.FS) fs.FS {
+	return overlayFS(layers)
+}
+
+type overlayFS []fs.FS
+
+// layer returns the index of the layer name resolves to.
+func (o overlayFS) layer(op, name string) (int, fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return -1, nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	for i, l := range o {
+		// any error, such as a file where a directory is looked
+		// for, means the layer does not have it
+		if finfo, err := fs.Stat(l, name); err == nil {
+			return i, finfo, nil
+		}
+	}
+	return -1, nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+}
+
+func (o overlayFS) Open(name string) (fs.File, error) {
+	i, finfo, err := o.layer("open", name)
+	if err != nil {
+		return nil, err
+	}
+	if !finfo.IsDir() {
+		return o[i].Open(name)
+	}
+	entries, err := o.readDir(i, name)
+	if err != nil {
+		return nil, err
+	}
+	return &dirFile{info: finfo, entries: entries}, nil
+}
+
+func (o overlayFS) Stat(name string) (fs.FileInfo, error) {
+	_, finfo, err := o.layer("stat", name)
+	return finfo, err
+}
+
+func (o overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	i, _, err := o.layer("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	return o.readDir(i, name)
+}
+
+// readDir merges the directory name of layer i and those below it.
+func (o overlayFS) readDir(i int, name string) ([]fs.DirEntry, error) {
+	seen := map[string]bool{}
+	var list []fs.DirEntry
+	for j, l := range o[i:] {
+		entries, err := fs.ReadDir(l, name)
+		if err != nil {
+			if j == 0 {
+				return nil, err
+			}
+			continue
+		}
+		for _, e := range entries {
+			if !seen[e.Name()] {
+				seen[e.Name()] = true
+				list = append(list, e)
+			}
+		}
+	}
+	sort.Slice(list, func(a, b int) bool { return list[a].Name() < list[b].Name() })
+	return list, nil
+}
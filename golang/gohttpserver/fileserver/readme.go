@@ -0,0 +1,60 @@
+package fileserver
+
+import (
+	"html/template"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"strings"
+)
+
+// readmeNames are the files shown with a directory listing, in order of
+// preference. Case is ignored.
+var readmeNames = []string{"readme.md", "readme", "index.md"}
+
+const readmeHead = `<link rel="stylesheet" type="text/css" href="/md.css"/>
+<link rel="stylesheet" type="text/css" href="/fa.css"/>
+<link rel="stylesheet" type="text/css" href="/hl.css"/>
+`
+
+// dirReadme renders the readme of dir for its listing, and where the
+// .serve.yml wants it: "above" or "below" the table. The html is empty
+// when there is no readme or the readme option is "none".
+func (s *Server) dirReadme(dir string, flist []os.FileInfo) (html, pos string) {
+	pos = s.dirConfigFor(dir).Readme
+	switch pos {
+	case "none":
+		return "", pos
+	case "above", "below":
+	case "":
+		pos = "below"
+	default:
+		log.Printf("%s: unknown readme position %q", dir, pos)
+		pos = "below"
+	}
+
+	for _, name := range readmeNames {
+		for _, f := range flist {
+			if f.IsDir() || strings.ToLower(f.Name()) != name {
+				continue
+			}
+			fpath := path.Join(dir, f.Name())
+			if !strings.HasSuffix(name, ".md") {
+				text, err := fs.ReadFile(s.fsys, fpath)
+				if err != nil {
+					log.Printf("%s: %v", fpath, err)
+					return "", pos
+				}
+				return "<pre>" + template.HTMLEscapeString(string(text)) + "</pre>\n", pos
+			}
+			page, err := s.cachedPage(fpath, f)
+			if err != nil {
+				log.Printf("%s: %v", fpath, err)
+				return "", pos
+			}
+			return page.body, pos
+		}
+	}
+	return "", pos
+}
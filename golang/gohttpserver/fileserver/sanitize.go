@@ -0,0 +1,110 @@
+package fileserver
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sync"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+type sanitizeConfig struct {
+	Policy          string              `yaml:"policy"`
+	AllowElements   []string            `yaml:"allow_elements"`
+	AllowAttributes map[string][]string `yaml:"allow_attributes"`
+	AllowURLSchemes []string            `yaml:"allow_url_schemes"`
+}
+
+// policies caches built policies by their configuration.
+var policies = struct {
+	sync.Mutex
+	m map[string]*bluemonday.Policy
+}{m: map[string]*bluemonday.Policy{}}
+
+// mathElements are the MathML elements latexToMathML writes.
+var mathElements = []string{"math", "semantics", "annotation", "mrow", "mi", "mn", "mo", "mtext",
+	"mspace", "msup", "msub", "msubsup", "mfrac", "msqrt", "mroot", "mover", "munder", "munderover",
+	"mtable", "mtr", "mtd", "mstyle", "mpadded", "mphantom", "menclose"}
+
+var mathAttrs = []string{"xmlns", "display", "mathvariant", "stretchy", "fence", "separator", "lspace",
+	"rspace", "width", "height", "depth", "linethickness", "displaystyle", "scriptlevel", "encoding",
+	"accent", "accentunder", "movablelimits", "largeop", "symmetric", "minsize", "maxsize", "notation",
+	"columnalign", "columnspacing", "rowspacing"}
+
+// sanitizePolicy returns the policy for markdown in dir, nil for raw HTML,
+// and a key telling policies apart in caches.
+func (s *Server) sanitizePolicy(dir string) (*bluemonday.Policy, string) {
+	cfg := s.dirConfigFor(dir).Sanitize
+	if cfg == nil {
+		cfg = &sanitizeConfig{}
+	}
+	name := cfg.Policy
+	if name == "" {
+		name = s.sanitize
+	}
+	if name == "" || name == "none" {
+		return nil, ""
+	}
+	key := fmt.Sprintf("%s %v %v %v", name, cfg.AllowElements, cfg.AllowAttributes, cfg.AllowURLSchemes)
+
+	policies.Lock()
+	defer policies.Unlock()
+	if p, ok := policies.m[key]; ok {
+		return p, key
+	}
+	var p *bluemonday.Policy
+	switch name {
+	case "ugc":
+		p = bluemonday.UGCPolicy()
+	case "strict":
+		p = bluemonday.NewPolicy()
+		p.AllowStandardURLs()
+		p.AllowElements("p", "br", "hr", "h1", "h2", "h3", "h4", "h5", "h6", "blockquote", "pre", "code",
+			"em", "strong", "del", "sup", "sub", "ul", "ol", "li", "dl", "dt", "dd",
+			"table", "thead", "tbody", "tr", "th", "td", "div", "span")
+		p.AllowAttrs("href", "title").OnElements("a")
+		p.AllowAttrs("src", "alt", "title").OnElements("img")
+		p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	default:
+		log.Printf("%s: unknown sanitize policy %q, using strict", dir, name)
+		p = bluemonday.StrictPolicy()
+	}
+
+	// what the markdown renderer itself writes
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\w\-:.]+$`)).Globally()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^[\w\- ]+$`)).Globally()
+	p.AllowAttrs("role").Matching(regexp.MustCompile(`^doc-[a-z]+$`)).Globally()
+	p.AllowElements("nav", "i")
+	p.AllowElements(mathElements...)
+	p.AllowAttrs(mathAttrs...).OnElements(mathElements...)
+	// \color
+	p.AllowAttrs("mathcolor").Matching(regexp.MustCompile(`^#?[a-zA-Z0-9]+$`)).OnElements("mrow")
+	p.AllowNoAttrs().OnElements(mathElements...)
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|right|center)$`)).OnElements("th", "td")
+	p.AllowStyles("text-align").MatchingEnum("left", "right", "center").OnElements("th", "td", "mtd")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+
+	p.AllowElements(cfg.AllowElements...)
+	for attr, elements := range cfg.AllowAttributes {
+		if len(elements) == 0 || (len(elements) == 1 && elements[0] == "*") {
+			p.AllowAttrs(attr).Globally()
+		} else {
+			p.AllowAttrs(attr).OnElements(elements...)
+		}
+	}
+	if len(cfg.AllowURLSchemes) != 0 {
+		p.AllowURLSchemes(cfg.AllowURLSchemes...)
+	}
+	policies.m[key] = p
+	return p, key
+}
+
+// sanitizeHTML applies the policy of dir to html rendered from markdown.
+func (s *Server) sanitizeHTML(dir, html string) string {
+	if p, _ := s.sanitizePolicy(dir); p != nil {
+		return p.Sanitize(html)
+	}
+	return html
+}
@@ -0,0 +1,187 @@
+package fileserver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// defaultHeaders go on every response unless a .serve.yml overrides them:
+//
+//	headers:
+//	  Referrer-Policy: no-referrer
+//	  X-Content-Type-Options: ""   # an empty value drops the header
+//	frame_ancestors: "'self' https://wiki.example.com"
+//	cors:
+//	  origins: [https://app.example.com, https://*.example.org]
+//	  methods: [GET, HEAD]
+//	  headers: [Authorization, Range]
+//	  expose: [Content-Length, ETag]
+//	  credentials: true
+//	  max_age: 600
+var defaultHeaders = map[string]string{
+	"X-Content-Type-Options": "nosniff",
+	"Referrer-Policy":        "strict-origin-when-cross-origin",
+}
+
+type corsConfig struct {
+	Origins     []string `yaml:"origins"`
+	Methods     []string `yaml:"methods"`
+	Headers     []string `yaml:"headers"`
+	Expose      []string `yaml:"expose"`
+	Credentials bool     `yaml:"credentials"`
+	MaxAge      int      `yaml:"max_age"`
+}
+
+// withSecurityHeaders sets the security headers of the directory a request
+// falls into, answers CORS preflights and marks allowed cross-origin
+// requests before handing them on.
+func (s *Server) withSecurityHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		c := s.dirConfigFor(s.existingDir(fsName(req.URL.Path)))
+
+		h := rw.Header()
+		for k, v := range defaultHeaders {
+			h.Set(k, v)
+		}
+		for k, v := range c.Headers {
+			if v == "" {
+				h.Del(k)
+			} else {
+				h.Set(k, v)
+			}
+		}
+		if s.hsts > 0 && (req.TLS != nil || req.Header.Get("X-Forwarded-Proto") == "https") {
+			h.Set("Strict-Transport-Security", fmt.Sprintf("max-age=%d; includeSubDomains", s.hsts))
+		}
+		ancestors := c.FrameAncestors
+		if ancestors == "" {
+			ancestors = "'self'"
+		}
+		// scriptNonce keeps this directive when it sets a page's policy
+		h.Set("Content-Security-Policy", "frame-ancestors "+ancestors)
+		switch ancestors {
+		case "'self'":
+			h.Set("X-Frame-Options", "SAMEORIGIN")
+		case "'none'":
+			h.Set("X-Frame-Options", "DENY")
+		}
+
+		if c.CORS != nil && c.CORS.cors(rw, req) {
+			return
+		}
+		next.ServeHTTP(rw, req)
+	})
+}
+
+// cors adds the CORS headers req is entitled to, and reports whether it
+// answered req as a preflight.
+func (c *corsConfig) cors(rw http.ResponseWriter, req *http.Request) bool {
+	h := rw.Header()
+	origin := req.Header.Get("Origin")
+	wildcard := false
+	allowed := false
+	for _, o := range c.Origins {
+		if o == "*" {
+			wildcard = true
+			allowed = true
+		} else if ok, _ := path.Match(strings.ToLower(o), strings.ToLower(origin)); ok {
+			allowed = true
+		}
+	}
+	if !wildcard || c.Credentials {
+		h.Add("Vary", "Origin")
+	}
+	if origin == "" {
+		return false
+	}
+
+	methods := c.Methods
+	if len(methods) == 0 {
+		methods = []string{"GET", "HEAD"}
+	}
+	preflight := req.Method == "OPTIONS" && req.Header.Get("Access-Control-Request-Method") != ""
+	if !allowed || preflight && !containsFold(methods, req.Header.Get("Access-Control-Request-Method")) {
+		if preflight {
+			// no CORS headers tell the browser the request is not allowed
+			rw.WriteHeader(http.StatusNoContent)
+		}
+		return preflight
+	}
+
+	if wildcard && !c.Credentials {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+	}
+	if c.Credentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if !preflight {
+		if len(c.Expose) != 0 {
+			h.Set("Access-Control-Expose-Headers", strings.Join(c.Expose, ", "))
+		}
+		return false
+	}
+
+	h.Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+	if containsFold(c.Headers, "*") {
+		if requested := req.Header.Get("Access-Control-Request-Headers"); requested != "" {
+			h.Set("Access-Control-Allow-Headers", requested)
+		}
+	} else if len(c.Headers) != 0 {
+		h.Set("Access-Control-Allow-Headers", strings.Join(c.Headers, ", "))
+	}
+	if c.MaxAge > 0 {
+		h.Set("Access-Control-Max-Age", strconv.Itoa(c.MaxAge))
+	}
+	h.Add("Vary", "Access-Control-Request-Method")
+	h.Add("Vary", "Access-Control-Request-Headers")
+	rw.WriteHeader(http.StatusNoContent)
+	return true
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// cspPolicy allows only scripts carrying the page's nonce, and what those
+// load in turn, so script injected through file names or markdown never
+// runs. Images may come from anywhere, as markdown links them freely.
+const cspPolicy = "default-src 'self'; script-src 'nonce-%s' 'strict-dynamic'; " +
+	"style-src 'self' 'unsafe-inline'; img-src * data:; font-src 'self' data:; " +
+	"frame-src 'self' https:; object-src 'none'; base-uri 'self'"
+
+// scriptNonce returns the nonce of the response's Content-Security-Policy,
+// setting the policy on first use and keeping directives already set.
+func scriptNonce(rw http.ResponseWriter) string {
+	csp := rw.Header().Get("Content-Security-Policy")
+	if i := strings.Index(csp, "'nonce-"); i >= 0 {
+		nonce := csp[i+len("'nonce-"):]
+		return nonce[:strings.IndexByte(nonce, '\'')]
+	}
+	b := make([]byte, 16)
+	rand.Read(b)
+	nonce := base64.RawURLEncoding.EncodeToString(b)
+	policy := fmt.Sprintf(cspPolicy, nonce)
+	if csp != "" {
+		// the frame-ancestors set by withSecurityHeaders
+		policy += "; " + csp
+	}
+	rw.Header().Set("Content-Security-Policy", policy)
+	return nonce
+}
+
+// withNonce lets the inline scripts of trusted HTML run under the policy.
+func withNonce(html, nonce string) string {
+	return strings.Replace(html, "<script", `<script nonce="`+nonce+`"`, -1)
+}
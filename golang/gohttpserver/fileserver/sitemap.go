@@ -0,0 +1,93 @@
+package fileserver
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+)
+
+// sitemaps may not list more than this many urls
+const sitemapLimit = 50000
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+func (s *Server) invalidateSitemap(name string) {
+	s.sitemap.Lock()
+	s.sitemap.valid = false
+	s.sitemap.Unlock()
+}
+
+func (s *Server) indexableURLs() []sitemapURL {
+	s.sitemap.Lock()
+	defer s.sitemap.Unlock()
+	if s.sitemap.valid {
+		return s.sitemap.urls
+	}
+	var urls []sitemapURL
+	s.walkTree(".", func(name string, finfo os.FileInfo) {
+		// scripts are run rather than read, and archives are
+		// downloads, not pages
+		if _, ok := s.scriptBackend(name); ok || isArchive(name) {
+			return
+		}
+		if s.rendered(name) {
+			meta, _ := s.readFrontMatter(name)
+			if meta.noindex() {
+				return
+			}
+		}
+		if len(urls) == sitemapLimit {
+			log.Printf("sitemap truncated at %d urls", sitemapLimit)
+		}
+		urls = append(urls, sitemapURL{urlPath(name), finfo.ModTime().UTC().Format(time.RFC3339)})
+	})
+	if len(urls) > sitemapLimit {
+		urls = urls[:sitemapLimit]
+	}
+	s.sitemap.urls, s.sitemap.valid = urls, true
+	return urls
+}
+
+// sitemapHandler lists every indexable file, with absolute URLs made by
+// baseURL.
+func (s *Server) sitemapHandler(rw http.ResponseWriter, req *http.Request) {
+	base := s.baseURL(req)
+	set := sitemapURLSet{}
+	for _, u := range s.indexableURLs() {
+		set.URLs = append(set.URLs, sitemapURL{base + u.Loc, u.LastMod})
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(set); err != nil {
+		s.httpError(rw, req, http.StatusInternalServerError, nil)
+		return
+	}
+	rw.Header().Set("content-type", "application/xml; charset=utf-8")
+	rw.Write(buf.Bytes())
+}
+
+// robotsHandler serves the robots setting of the root .serve.yml, or a
+// default that allows everything and points at the sitemap.
+func (s *Server) robotsHandler(rw http.ResponseWriter, req *http.Request) {
+	rw.Header().Set("content-type", "text/plain; charset=utf-8")
+	if robots := s.dirConfigFor(".").Robots; robots != "" {
+		io.WriteString(rw, robots)
+		return
+	}
+	fmt.Fprintf(rw, "User-agent: *\nAllow: /\n\nSitemap: %s/sitemap.xml\n", s.baseURL(req))
+}
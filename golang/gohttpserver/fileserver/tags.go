@@ -0,0 +1,214 @@
+package fileserver
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// article is what the indexes and feeds need to know about a markdown file.
+type article struct {
+	url     string
+	meta    *frontMatter
+	excerpt string
+	mtime   time.Time
+}
+
+// tagIndex maps front matter tags to the articles carrying them. It is
+// rebuilt lazily after any page or directory in the tree changes.
+type tagIndex struct {
+	mu    sync.Mutex
+	valid bool
+	tags  map[string][]*article
+}
+
+func tagURL(tag string) string {
+	return "/_tags/" + url.PathEscape(tag)
+}
+
+// urlPath turns the name of a file into an escaped URL path.
+func urlPath(name string) string {
+	if name == "." {
+		return "/"
+	}
+	return (&url.URL{Path: "/" + name}).EscapedPath()
+}
+
+// readArticle reads the front matter and excerpt of the page name. Pages
+// that fail to render, or crash the renderer, give no article, so one bad
+// page cannot take the indexes listing it down.
+func (s *Server) readArticle(name string, finfo os.FileInfo) (a *article, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			a, err = nil, fmt.Errorf("rendering excerpt: %v", v)
+		}
+	}()
+	text, err := fs.ReadFile(s.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	meta, body, err := splitFrontMatter(text)
+	excerpt, rerr := s.renderExcerpt(name, body)
+	if rerr != nil {
+		return nil, fmt.Errorf("rendering excerpt: %v", rerr)
+	}
+	a = &article{
+		url:     urlPath(name),
+		meta:    meta,
+		excerpt: s.sanitizeHTML(path.Dir(name), excerpt),
+		mtime:   finfo.ModTime(),
+	}
+	return a, err
+}
+
+// walkTree calls fn for every file below dir that is neither a dotfile
+// nor ignored, skipping ignored and dot directories, and watches every
+// directory it enters.
+func (s *Server) walkTree(dir string, fn func(name string, finfo os.FileInfo)) {
+	fs.WalkDir(s.fsys, dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if name != dir && (strings.HasPrefix(d.Name(), ".") || s.ignored(name, d.IsDir())) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			s.watch(name)
+			return nil
+		}
+		if finfo, err := d.Info(); err == nil {
+			fn(name, finfo)
+		}
+		return nil
+	})
+}
+
+// walkPages is walkTree of the files rendered to pages.
+func (s *Server) walkPages(dir string, fn func(name string, finfo os.FileInfo)) {
+	s.walkTree(dir, func(name string, finfo os.FileInfo) {
+		if s.rendered(name) {
+			fn(name, finfo)
+		}
+	})
+}
+
+func (ti *tagIndex) invalidate(s *Server, name string) {
+	if finfo, err := s.stat(name); err == nil && !finfo.IsDir() &&
+		!s.rendered(name) && path.Base(name) != ".hidden" && path.Base(name) != ignoreName {
+		return
+	}
+	ti.mu.Lock()
+	ti.valid = false
+	ti.mu.Unlock()
+}
+
+func (ti *tagIndex) get(s *Server) map[string][]*article {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	if ti.valid {
+		return ti.tags
+	}
+	tags := map[string][]*article{}
+	s.walkPages(".", func(name string, finfo os.FileInfo) {
+		a, err := s.readArticle(name, finfo)
+		if err != nil {
+			log.Printf("%s: %v", name, err)
+		}
+		if a == nil || a.meta.draft() || len(a.meta.tags) == 0 {
+			return
+		}
+		for _, tag := range a.meta.tags {
+			tags[tag] = append(tags[tag], a)
+		}
+	})
+	for _, list := range tags {
+		sortArticles(list)
+	}
+	ti.tags, ti.valid = tags, true
+	return tags
+}
+
+// sortArticles orders newest first, by front matter date or else mtime.
+func sortArticles(list []*article) {
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := articleTime(list[i]), articleTime(list[j])
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return articleTitle(list[i]) < articleTitle(list[j])
+	})
+}
+
+func articleTitle(a *article) string {
+	if a.meta.title != "" {
+		return a.meta.title
+	}
+	name, _ := url.PathUnescape(a.url[strings.LastIndex(a.url, "/")+1:])
+	return name
+}
+
+func (s *Server) tagsHandler(rw http.ResponseWriter, req *http.Request) {
+	tag := strings.TrimPrefix(req.URL.Path, "/_tags/")
+	tags := s.tags.get(s)
+	body := &bytes.Buffer{}
+	var title, feeds, head string
+
+	if tag == "" {
+		title = "Tags"
+		names := make([]string, 0, len(tags))
+		for name := range tags {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		body.WriteString("<h1>Tags</h1>\n<ul class=\"tags\">\n")
+		for _, name := range names {
+			fmt.Fprintf(body, "<li><a class=\"tag\" href=\"%s\">%s</a> (%d)</li>\n",
+				tagURL(name), template.HTMLEscapeString(name), len(tags[name]))
+		}
+		body.WriteString("</ul>\n")
+	} else {
+		list, ok := tags[tag]
+		if !ok {
+			s.httpError(rw, req, http.StatusNotFound, nil)
+			return
+		}
+		title = "Tag: " + tag
+		if kind := req.FormValue("feed"); kind != "" {
+			s.writeFeed(rw, req, kind, title, "Articles tagged "+tag, tagURL(tag), list)
+			return
+		}
+		feeds = feedLinks(tagURL(tag))
+		head = feedHead(tagURL(tag))
+		fmt.Fprintf(body, "<h1><i class=\"fa fa-tag\"></i> %s</h1>\n<ul class=\"articles\">\n", template.HTMLEscapeString(tag))
+		for _, a := range list {
+			fmt.Fprintf(body, "<li><a href=\"%s\">%s</a>", a.url, template.HTMLEscapeString(articleTitle(a)))
+			if date := a.meta.dateString(); date != "" {
+				fmt.Fprintf(body, " <i class=\"fa fa-calendar\"></i>%s", template.HTMLEscapeString(date))
+			}
+			body.WriteString("</li>\n")
+		}
+		body.WriteString("</ul>\n")
+	}
+
+	m := map[string]interface{}{
+		"title":       title,
+		"head":        head,
+		"mdbody":      body.String(),
+		"articlemeta": `<a href="/_tags/"><i class="fa fa-tags catalog"></i></a>` + feeds,
+	}
+	rw.Header().Set("content-type", "text/html; charset=utf-8")
+	s.executePage(rw, ".", "markdown", m)
+}
@@ -0,0 +1,43 @@
+package fileserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+)
+
+func TestTagIndex(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.md":      {Data: []byte("---\ntags: go\n---\nfine\n")},
+		"draft.md":  {Data: []byte("---\ntags: go\ndraft: true\n---\nnot yet\n")},
+		"bad.adoc":  {Data: []byte("---\ntags: go\n---\npanics\n")},
+		"fail.adoc": {Data: []byte("---\ntags: go\n---\nfails\n")},
+	}
+	s, err := New(WithFS(fsys), WithRenderer(".adoc", func(src []byte) ([]byte, error) {
+		if string(src) == "panics" {
+			panic("renderer bug")
+		}
+		return nil, http.ErrNotSupported
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	list := s.tags.get(s)["go"]
+	if len(list) != 1 || list[0].url != "/a.md" {
+		var urls []string
+		for _, a := range list {
+			urls = append(urls, a.url)
+		}
+		t.Errorf("tag go lists %v, want [/a.md]", urls)
+	}
+	for _, upath := range []string{"/_tags/", "/_tags/go"} {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest("GET", upath, nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("GET %s: %d", upath, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package fileserver
+
+import (
+	"html/template"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// BlogPost is a post on a page of a blog index, see WithTemplates.
+type BlogPost struct {
+	URL, Title, Date, Author string
+	Excerpt                  template.HTML
+	Tags                     []string
+}
+
+// ListEntry is a file or directory of a listing, see WithTemplates.
+type ListEntry struct {
+	Name, URL   string
+	IsDir       bool
+	Size        int64
+	SizeString  string
+	ModTime     int64
+	ModTimeText string
+	Layer       string // the layer it comes from, see WithLayerMarkers
+}
+
+// templateKey names a template file: in the served tree when site is
+// set, or else among WithTemplates.
+type templateKey struct {
+	site bool
+	name string
+}
+
+func (s *Server) loadTemplate(key templateKey) *template.Template {
+	s.userTemplates.Lock()
+	defer s.userTemplates.Unlock()
+	if t, ok := s.userTemplates.m[key]; ok {
+		return t
+	}
+	fsys := s.templates
+	if key.site {
+		fsys = s.fsys
+		s.watch(path.Dir(key.name))
+	} else if s.templateDir != "" {
+		watcher.add(filepath.Join(s.templateDir, filepath.FromSlash(path.Dir(key.name))))
+	}
+	var t *template.Template
+	if _, err := fs.Stat(fsys, key.name); err == nil {
+		all, err := template.ParseFS(fsys, path.Join(path.Dir(key.name), "*.html"))
+		if err != nil {
+			log.Printf("%s: %v", key.name, err)
+		} else {
+			t = all.Lookup(path.Base(key.name))
+		}
+	} else if !os.IsNotExist(err) {
+		log.Printf("%s: %v", key.name, err)
+	}
+	s.userTemplates.m[key] = t
+	return t
+}
+
+func (s *Server) invalidateTemplates(changed templateKey) {
+	s.userTemplates.Lock()
+	defer s.userTemplates.Unlock()
+	for key := range s.userTemplates.m {
+		if key.site == changed.site && path.Dir(key.name) == path.Dir(changed.name) {
+			delete(s.userTemplates.m, key)
+		}
+	}
+}
+
+// userTemplate returns the site's template of the given kind for pages in
+// dir, or nil to use the built-in one.
+func (s *Server) userTemplate(dir, kind string) *template.Template {
+	if name, ok := s.dirConfigFor(dir).Templates[kind]; ok {
+		if t := s.loadTemplate(templateKey{true, name}); t != nil {
+			return t
+		}
+	}
+	if s.templates != nil {
+		return s.loadTemplate(templateKey{false, kind + ".html"})
+	}
+	return nil
+}
+
+// htmlKeys are the page fields holding trusted HTML.
+var htmlKeys = []string{"mdbody", "articlemeta", "head", "theme", "comments", "livereload", "readme"}
+
+// htmlFields marks the HTML fields of data as safe for html/template.
+func htmlFields(data map[string]interface{}) map[string]interface{} {
+	for _, key := range htmlKeys {
+		if s, ok := data[key].(string); ok {
+			data[key] = template.HTML(s)
+		}
+	}
+	return data
+}
+
+// executePage renders a markdown-like page with the site's template of the
+// given kind, falling back to the markdown one and then to mdTmpl.
+func (s *Server) executePage(rw http.ResponseWriter, dir, kind string, data map[string]interface{}) {
+	t := s.userTemplate(dir, kind)
+	if t == nil && kind != "markdown" {
+		t = s.userTemplate(dir, "markdown")
+	}
+	if t == nil {
+		t = mdTmpl
+	}
+	if _, ok := data["theme"]; !ok {
+		data["theme"] = s.themeLinks(dir)
+	}
+	data["nonce"] = scriptNonce(rw)
+	if err := t.Execute(rw, htmlFields(data)); err != nil {
+		log.Printf("%s: %v", t.Name(), err)
+	}
+}
+
+// mergeTemplatePaths resolves the template paths set by the .serve.yml in
+// dir and adds the ones inherited from the parent.
+func mergeTemplatePaths(dir string, own, inherited map[string]string) map[string]string {
+	paths := map[string]string{}
+	for kind, p := range inherited {
+		paths[kind] = p
+	}
+	for kind, p := range own {
+		p = strings.TrimSpace(p)
+		if strings.HasPrefix(p, "/") {
+			p = fsName(p)
+		} else {
+			p = fsName(path.Join(dir, p))
+		}
+		paths[kind] = p
+	}
+	return paths
+}
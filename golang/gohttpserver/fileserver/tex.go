@@ -0,0 +1,688 @@
+package fileserver
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A small LaTeX to MathML translator covering the math people actually put
+// in design docs. Browsers render MathML natively, so pages need no script.
+
+var texIdentifiers = map[string]string{
+	"alpha": "α", "beta": "β", "gamma": "γ", "delta": "δ", "epsilon": "ϵ",
+	"varepsilon": "ε", "zeta": "ζ", "eta": "η", "theta": "θ", "vartheta": "ϑ",
+	"iota": "ι", "kappa": "κ", "lambda": "λ", "mu": "μ", "nu": "ν", "xi": "ξ",
+	"omicron": "ο", "pi": "π", "varpi": "ϖ", "rho": "ρ", "varrho": "ϱ",
+	"sigma": "σ", "varsigma": "ς", "tau": "τ", "upsilon": "υ", "phi": "ϕ",
+	"varphi": "φ", "chi": "χ", "psi": "ψ", "omega": "ω",
+	"Gamma": "Γ", "Delta": "Δ", "Theta": "Θ", "Lambda": "Λ", "Xi": "Ξ",
+	"Pi": "Π", "Sigma": "Σ", "Upsilon": "Υ", "Phi": "Φ", "Psi": "Ψ", "Omega": "Ω",
+	"infty": "∞", "partial": "∂", "nabla": "∇", "emptyset": "∅", "varnothing": "∅",
+	"hbar": "ℏ", "ell": "ℓ", "Re": "ℜ", "Im": "ℑ", "aleph": "ℵ", "wp": "℘",
+	"angle": "∠", "triangle": "△", "top": "⊤", "bot": "⊥", "imath": "ı", "jmath": "ȷ",
+}
+
+var texOperators = map[string]string{
+	"pm": "±", "mp": "∓", "times": "×", "div": "÷", "cdot": "⋅", "ast": "∗",
+	"star": "⋆", "circ": "∘", "bullet": "∙", "oplus": "⊕", "ominus": "⊖",
+	"otimes": "⊗", "odot": "⊙", "setminus": "∖", "cap": "∩", "cup": "∪",
+	"wedge": "∧", "land": "∧", "vee": "∨", "lor": "∨", "neg": "¬", "lnot": "¬",
+	"leq": "≤", "le": "≤", "geq": "≥", "ge": "≥", "neq": "≠", "ne": "≠",
+	"approx": "≈", "equiv": "≡", "sim": "∼", "simeq": "≃", "cong": "≅",
+	"propto": "∝", "ll": "≪", "gg": "≫", "prec": "≺", "succ": "≻",
+	"preceq": "⪯", "succeq": "⪰", "subset": "⊂", "supset": "⊃",
+	"subseteq": "⊆", "supseteq": "⊇", "in": "∈", "notin": "∉", "ni": "∋",
+	"mid": "∣", "parallel": "∥", "perp": "⊥", "models": "⊨", "vdash": "⊢",
+	"dashv": "⊣", "to": "→", "rightarrow": "→", "gets": "←", "leftarrow": "←",
+	"leftrightarrow": "↔", "Rightarrow": "⇒", "Leftarrow": "⇐",
+	"Leftrightarrow": "⇔", "iff": "⟺", "implies": "⟹", "mapsto": "↦",
+	"longrightarrow": "⟶", "longleftarrow": "⟵", "uparrow": "↑",
+	"downarrow": "↓", "hookrightarrow": "↪", "forall": "∀", "exists": "∃",
+	"nexists": "∄", "ldots": "…", "dots": "…", "cdots": "⋯", "vdots": "⋮",
+	"ddots": "⋱", "prime": "′", "colon": ":", "langle": "⟨", "rangle": "⟩",
+	"lfloor": "⌊", "rfloor": "⌋", "lceil": "⌈", "rceil": "⌉", "vert": "|",
+	"lvert": "|", "rvert": "|", "Vert": "‖", "lVert": "‖", "rVert": "‖",
+	"|": "‖", "{": "{", "}": "}", "backslash": "∖", "#": "#", "%": "%",
+	"&": "&", "$": "$", "_": "_",
+}
+
+var texLargeOps = map[string]string{
+	"sum": "∑", "prod": "∏", "coprod": "∐", "int": "∫", "iint": "∬",
+	"iiint": "∭", "oint": "∮", "bigcup": "⋃", "bigcap": "⋂", "bigoplus": "⨁",
+	"bigotimes": "⨂", "bigvee": "⋁", "bigwedge": "⋀",
+}
+
+// function names, true when they take limits underneath in display mode
+var texFunctions = map[string]bool{
+	"sin": false, "cos": false, "tan": false, "cot": false, "sec": false,
+	"csc": false, "sinh": false, "cosh": false, "tanh": false, "arcsin": false,
+	"arccos": false, "arctan": false, "log": false, "ln": false, "lg": false,
+	"exp": false, "deg": false, "dim": false, "hom": false, "ker": false,
+	"arg": false, "lim": true, "liminf": true, "limsup": true, "max": true,
+	"min": true, "sup": true, "inf": true, "det": true, "gcd": true, "Pr": true,
+}
+
+var texAccents = map[string]string{
+	"hat": "^", "widehat": "^", "bar": "¯", "overline": "‾", "vec": "→",
+	"overrightarrow": "→", "tilde": "~", "widetilde": "~", "dot": "˙",
+	"ddot": "¨", "check": "ˇ", "breve": "˘", "acute": "´", "grave": "`",
+}
+
+var texSpaces = map[string]string{
+	",": "0.1667em", ":": "0.2222em", ">": "0.2222em", ";": "0.2778em",
+	" ": "0.25em", "quad": "1em", "qquad": "2em", "!": "-0.1667em",
+}
+
+var texBigSizes = map[string]string{
+	"big": "1.2em", "bigl": "1.2em", "bigr": "1.2em",
+	"Big": "1.623em", "Bigl": "1.623em", "Bigr": "1.623em",
+	"bigg": "2.047em", "biggl": "2.047em", "biggr": "2.047em",
+	"Bigg": "2.470em", "Biggl": "2.470em", "Biggr": "2.470em",
+}
+
+var texFonts = map[string]string{
+	"mathrm": "normal", "mathbf": "bold", "mathit": "italic",
+	"mathbb": "double-struck", "mathcal": "script", "mathscr": "script",
+	"mathfrak": "fraktur", "mathsf": "sans-serif", "mathtt": "monospace",
+	"boldsymbol": "bold-italic", "bm": "bold-italic",
+}
+
+// matrix environments and the fences around them
+var texMatrices = map[string][2]string{
+	"matrix": {"", ""}, "smallmatrix": {"", ""}, "pmatrix": {"(", ")"},
+	"bmatrix": {"[", "]"}, "Bmatrix": {"{", "}"}, "vmatrix": {"|", "|"},
+	"Vmatrix": {"‖", "‖"}, "cases": {"{", ""}, "array": {"", ""},
+	"aligned": {"", ""}, "align": {"", ""}, "align*": {"", ""},
+	"gathered": {"", ""}, "gather": {"", ""}, "gather*": {"", ""},
+	"split": {"", ""},
+}
+
+type texParser struct {
+	src     string
+	pos     int
+	display bool
+	font    string
+	depth   int
+	fn      bool // the last atom was a function name like \sin
+}
+
+// latexToMathML renders src as a <math> element, or fails with a message
+// suitable for showing next to the source.
+func latexToMathML(src string, display bool) (string, error) {
+	p := &texParser{src: src, display: display}
+	body, err := p.parseTable("", [2]string{})
+	if err != nil {
+		return "", err
+	}
+	mode := "inline"
+	if display {
+		mode = "block"
+	}
+	return fmt.Sprintf(`<math xmlns="http://www.w3.org/1998/Math/MathML" display="%s">`+
+		`<semantics><mrow>%s</mrow><annotation encoding="application/x-tex">%s</annotation></semantics></math>`,
+		mode, body, template.HTMLEscapeString(src)), nil
+}
+
+func (p *texParser) skipSpace() {
+	for p.pos < len(p.src) {
+		r, n := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += n
+	}
+}
+
+// next lexes one token: a command like `\alpha` or `\{`, or a single rune.
+func (p *texParser) next() string {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return ""
+	}
+	start := p.pos
+	if p.src[p.pos] == '\\' {
+		p.pos++
+		if p.pos >= len(p.src) {
+			return "\\"
+		}
+		if !isASCIILetter(p.src[p.pos]) {
+			_, n := utf8.DecodeRuneInString(p.src[p.pos:])
+			p.pos += n
+			return p.src[start:p.pos]
+		}
+		for p.pos < len(p.src) && isASCIILetter(p.src[p.pos]) {
+			p.pos++
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == '*' {
+			p.pos++
+		}
+		return p.src[start:p.pos]
+	}
+	_, n := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.pos += n
+	return p.src[start:p.pos]
+}
+
+func (p *texParser) peek() string {
+	pos := p.pos
+	tok := p.next()
+	p.pos = pos
+	return tok
+}
+
+func (p *texParser) expect(tok string) error {
+	if got := p.next(); got != tok {
+		if got == "" {
+			return fmt.Errorf("missing %q", tok)
+		}
+		return fmt.Errorf("expected %q, found %q", tok, got)
+	}
+	return nil
+}
+
+// rawGroup returns the verbatim contents of a {...} group.
+func (p *texParser) rawGroup() (string, error) {
+	if err := p.expect("{"); err != nil {
+		return "", err
+	}
+	start, level := p.pos, 1
+	for ; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos++
+		case '{':
+			level++
+		case '}':
+			level--
+			if level == 0 {
+				p.pos++
+				return p.src[start : p.pos-1], nil
+			}
+		}
+	}
+	return "", fmt.Errorf("missing \"}\"")
+}
+
+// parseRow parses atoms until one of stops (left unconsumed) or the end.
+func (p *texParser) parseRow(stops ...string) (string, string, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > 64 {
+		return "", "", fmt.Errorf("nested too deeply")
+	}
+	out := &bytes.Buffer{}
+	for {
+		tok := p.peek()
+		for _, s := range stops {
+			if tok == s {
+				return out.String(), tok, nil
+			}
+		}
+		switch tok {
+		case "":
+			if len(stops) != 0 && stops[0] != "" {
+				return "", "", fmt.Errorf("missing %q", stops[0])
+			}
+			return out.String(), "", nil
+		case "}":
+			return "", "", fmt.Errorf("unexpected \"}\"")
+		case "&", "\\\\":
+			return "", "", fmt.Errorf("unexpected %q outside of an environment", tok)
+		}
+		ml, err := p.parseScripted()
+		if err != nil {
+			return "", "", err
+		}
+		out.WriteString(ml)
+	}
+}
+
+// parseScripted parses an atom and the sub- and superscripts attached to it.
+func (p *texParser) parseScripted() (string, error) {
+	var base string
+	var limits bool
+	if tok := p.peek(); tok == "^" || tok == "_" {
+		base = "<mrow></mrow>"
+	} else {
+		var err error
+		base, limits, err = p.parseAtom()
+		if err != nil {
+			return "", err
+		}
+	}
+	fn := p.fn
+	p.fn = false
+
+	var sub, sup string
+	for {
+		tok := p.peek()
+		if tok == "\\limits" || tok == "\\nolimits" {
+			p.next()
+			limits = tok == "\\limits"
+			continue
+		}
+		if tok == "'" {
+			p.next()
+			sup += "<mo>′</mo>"
+			continue
+		}
+		if tok != "^" && tok != "_" {
+			break
+		}
+		p.next()
+		arg, err := p.parseArg()
+		if err != nil {
+			return "", err
+		}
+		if tok == "^" {
+			if sup != "" && !strings.HasSuffix(sup, "<mo>′</mo>") {
+				return "", fmt.Errorf("double superscript")
+			}
+			sup += arg
+		} else {
+			if sub != "" {
+				return "", fmt.Errorf("double subscript")
+			}
+			sub = arg
+		}
+	}
+
+	under, over, both := "msub", "msup", "msubsup"
+	if limits {
+		under, over, both = "munder", "mover", "munderover"
+	}
+	ml := base
+	switch {
+	case sub != "" && sup != "":
+		ml = fmt.Sprintf("<%s>%s<mrow>%s</mrow><mrow>%s</mrow></%s>", both, base, sub, sup, both)
+	case sub != "":
+		ml = fmt.Sprintf("<%s>%s<mrow>%s</mrow></%s>", under, base, sub, under)
+	case sup != "":
+		ml = fmt.Sprintf("<%s>%s<mrow>%s</mrow></%s>", over, base, sup, over)
+	}
+	if fn {
+		ml += `<mspace width="0.1667em"></mspace>`
+	}
+	return ml, nil
+}
+
+// parseArg parses a command argument: a group or a single atom.
+func (p *texParser) parseArg() (string, error) {
+	switch tok := p.peek(); tok {
+	case "":
+		return "", fmt.Errorf("missing argument")
+	case "{":
+		ml, _, err := p.parseAtom()
+		return ml, err
+	case "}", "^", "_", "&", "\\\\":
+		return "", fmt.Errorf("missing argument before %q", tok)
+	}
+	ml, _, err := p.parseAtom()
+	return ml, err
+}
+
+func (p *texParser) parseGroup() (string, error) {
+	if err := p.expect("{"); err != nil {
+		return "", err
+	}
+	row, _, err := p.parseRow("}")
+	if err != nil {
+		return "", err
+	}
+	p.next()
+	return "<mrow>" + row + "</mrow>", nil
+}
+
+// parseAtom parses a single token or group. limits reports whether
+// scripts on it belong above and below rather than to the side.
+func (p *texParser) parseAtom() (ml string, limits bool, err error) {
+	p.skipSpace()
+	start := p.pos
+	tok := p.next()
+	switch {
+	case tok == "":
+		return "", false, fmt.Errorf("missing argument")
+	case tok == "{":
+		p.pos = start
+		ml, err = p.parseGroup()
+		return ml, false, err
+	case len(tok) == 1 && isASCIILetter(tok[0]):
+		return p.identifier(tok), false, nil
+	case len(tok) == 1 && tok[0] >= '0' && tok[0] <= '9':
+		for p.pos < len(p.src) && (p.src[p.pos] == '.' || p.src[p.pos] >= '0' && p.src[p.pos] <= '9') {
+			p.pos++
+		}
+		num := strings.TrimRight(p.src[start:p.pos], ".")
+		p.pos = start + len(num)
+		return "<mn>" + p.styled(num) + "</mn>", false, nil
+	case tok == "-":
+		return "<mo>−</mo>", false, nil
+	case tok == "~":
+		return "<mtext>&#160;</mtext>", false, nil
+	case tok == "'":
+		return "<mo>′</mo>", false, nil
+	case len(tok) == 1 && strings.ContainsAny(tok, "+=<>,;:!()[]|/*.?@\""):
+		return "<mo>" + template.HTMLEscapeString(tok) + "</mo>", false, nil
+	case tok[0] != '\\':
+		if r, _ := utf8.DecodeRuneInString(tok); unicode.IsLetter(r) {
+			return "<mi>" + template.HTMLEscapeString(tok) + "</mi>", false, nil
+		}
+		return "<mo>" + template.HTMLEscapeString(tok) + "</mo>", false, nil
+	}
+	return p.parseCommand(tok[1:])
+}
+
+func (p *texParser) identifier(s string) string {
+	if p.font == "" {
+		return "<mi>" + s + "</mi>"
+	}
+	if p.font == "normal" {
+		return `<mi mathvariant="normal">` + s + "</mi>"
+	}
+	return `<mi mathvariant="normal">` + p.styled(s) + "</mi>"
+}
+
+func (p *texParser) parseCommand(name string) (string, bool, error) {
+	if s, ok := texIdentifiers[name]; ok {
+		return p.identifier(s), false, nil
+	}
+	if s, ok := texOperators[name]; ok {
+		return "<mo>" + template.HTMLEscapeString(s) + "</mo>", false, nil
+	}
+	if s, ok := texLargeOps[name]; ok {
+		return `<mo largeop="true" movablelimits="true">` + s + "</mo>", p.display && !strings.Contains(name, "int"), nil
+	}
+	if limits, ok := texFunctions[name]; ok {
+		p.fn = true
+		return "<mi>" + name + "</mi>", limits && p.display, nil
+	}
+	if width, ok := texSpaces[name]; ok {
+		return `<mspace width="` + width + `"></mspace>`, false, nil
+	}
+	if size, ok := texBigSizes[name]; ok {
+		d, err := p.delimiter()
+		return fmt.Sprintf(`<mo minsize="%s" maxsize="%s">%s</mo>`, size, size, d), false, err
+	}
+	if accent, ok := texAccents[name]; ok {
+		arg, err := p.parseArg()
+		stretchy := "false"
+		if strings.HasPrefix(name, "wide") || strings.HasPrefix(name, "over") {
+			stretchy = "true"
+		}
+		return fmt.Sprintf(`<mover accent="true">%s<mo stretchy="%s">%s</mo></mover>`, arg, stretchy, accent), false, err
+	}
+	if variant, ok := texFonts[name]; ok {
+		saved := p.font
+		p.font = variant
+		arg, err := p.parseArg()
+		p.font = saved
+		return arg, false, err
+	}
+
+	switch name {
+	case "frac", "dfrac", "tfrac", "cfrac":
+		num, err := p.parseArg()
+		if err != nil {
+			return "", false, err
+		}
+		den, err := p.parseArg()
+		return "<mfrac>" + num + den + "</mfrac>", false, err
+	case "binom":
+		n, err := p.parseArg()
+		if err != nil {
+			return "", false, err
+		}
+		k, err := p.parseArg()
+		return `<mrow><mo>(</mo><mfrac linethickness="0">` + n + k + `</mfrac><mo>)</mo></mrow>`, false, err
+	case "sqrt":
+		if p.peek() == "[" {
+			p.next()
+			index, _, err := p.parseRow("]")
+			if err != nil {
+				return "", false, err
+			}
+			p.next()
+			arg, err := p.parseArg()
+			return "<mroot>" + arg + "<mrow>" + index + "</mrow></mroot>", false, err
+		}
+		arg, err := p.parseArg()
+		return "<msqrt>" + arg + "</msqrt>", false, err
+	case "text", "textrm", "textit", "textbf", "mbox", "operatorname":
+		s, err := p.rawGroup()
+		if err != nil {
+			return "", false, err
+		}
+		if name == "operatorname" {
+			p.fn = true
+			return "<mi>" + template.HTMLEscapeString(s) + "</mi>", false, nil
+		}
+		return "<mtext>" + template.HTMLEscapeString(s) + "</mtext>", false, nil
+	case "underline":
+		arg, err := p.parseArg()
+		return `<munder accentunder="true">` + arg + `<mo stretchy="true">_</mo></munder>`, false, err
+	case "overbrace", "underbrace":
+		arg, err := p.parseArg()
+		if name == "overbrace" {
+			return `<mover>` + arg + `<mo stretchy="true">⏞</mo></mover>`, true, err
+		}
+		return `<munder>` + arg + `<mo stretchy="true">⏟</mo></munder>`, true, err
+	case "overset", "stackrel", "underset":
+		script, err := p.parseArg()
+		if err != nil {
+			return "", false, err
+		}
+		base, err := p.parseArg()
+		if name == "underset" {
+			return "<munder>" + base + script + "</munder>", false, err
+		}
+		return "<mover>" + base + script + "</mover>", false, err
+	case "not":
+		arg, err := p.parseArg()
+		if err != nil {
+			return "", false, err
+		}
+		return strings.Replace(arg, "</mo>", "̸</mo>", 1), false, nil
+	case "color", "textcolor":
+		color, err := p.rawGroup()
+		if err != nil {
+			return "", false, err
+		}
+		if strings.Trim(color, "#abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789") != "" {
+			return "", false, fmt.Errorf("bad color %q", color)
+		}
+		arg, err := p.parseArg()
+		return `<mrow mathcolor="` + color + `">` + arg + "</mrow>", false, err
+	case "left":
+		open, err := p.delimiter()
+		if err != nil {
+			return "", false, err
+		}
+		row, _, err := p.parseRow("\\right")
+		if err != nil {
+			return "", false, err
+		}
+		p.next()
+		closing, err := p.delimiter()
+		return `<mrow><mo fence="true" stretchy="true">` + open + "</mo>" + row +
+			`<mo fence="true" stretchy="true">` + closing + "</mo></mrow>", false, err
+	case "right":
+		return "", false, fmt.Errorf(`\right without \left`)
+	case "begin":
+		env, err := p.rawGroup()
+		if err != nil {
+			return "", false, err
+		}
+		fences, ok := texMatrices[env]
+		if !ok {
+			return "", false, fmt.Errorf("unknown environment %q", env)
+		}
+		if env == "array" {
+			if _, err := p.rawGroup(); err != nil {
+				return "", false, err
+			}
+		}
+		table, err := p.parseTable(env, fences)
+		if err != nil {
+			return "", false, err
+		}
+		if err := p.expect("\\end"); err != nil {
+			return "", false, err
+		}
+		end, err := p.rawGroup()
+		if err != nil {
+			return "", false, err
+		}
+		if end != env {
+			return "", false, fmt.Errorf(`\begin{%s} ended by \end{%s}`, env, end)
+		}
+		return table, false, nil
+	case "end":
+		return "", false, fmt.Errorf(`\end without \begin`)
+	}
+	return "", false, fmt.Errorf(`unknown command \%s`, name)
+}
+
+// delimiter reads the fence following \left, \right or \big.
+func (p *texParser) delimiter() (string, error) {
+	tok := p.next()
+	switch {
+	case tok == "":
+		return "", fmt.Errorf("missing delimiter")
+	case tok == ".":
+		return "", nil
+	case tok[0] == '\\':
+		if s, ok := texOperators[tok[1:]]; ok {
+			return template.HTMLEscapeString(s), nil
+		}
+		return "", fmt.Errorf("bad delimiter %q", tok)
+	case strings.Contains("()[]|/<>", tok):
+		if tok == "<" {
+			return "⟨", nil
+		} else if tok == ">" {
+			return "⟩", nil
+		}
+		return tok, nil
+	}
+	return "", fmt.Errorf("bad delimiter %q", tok)
+}
+
+// parseTable parses rows separated by \\ and cells separated by &, up to
+// \end or the end of input. A single cell comes back as a plain row.
+func (p *texParser) parseTable(env string, fences [2]string) (string, error) {
+	stop := "\\end"
+	if env == "" {
+		stop = ""
+	}
+	var rows [][]string
+	var cells []string
+	for {
+		cell, tok, err := p.parseRow(stop, "&", "\\\\")
+		if err != nil {
+			return "", err
+		}
+		cells = append(cells, cell)
+		if tok == "&" || tok == "\\\\" {
+			p.next()
+		}
+		if tok != "&" {
+			rows = append(rows, cells)
+			cells = nil
+		}
+		if tok == stop {
+			break
+		}
+	}
+	if last := rows[len(rows)-1]; len(rows) > 1 && len(last) == 1 && last[0] == "" {
+		rows = rows[:len(rows)-1] // trailing \\
+	}
+	if env == "" && len(rows) == 1 && len(rows[0]) == 1 {
+		return rows[0][0], nil
+	}
+
+	align := func(col int) string { return "center" }
+	switch env {
+	case "", "aligned", "align", "align*", "split":
+		align = func(col int) string {
+			if col%2 == 0 {
+				return "right"
+			}
+			return "left"
+		}
+	case "cases":
+		align = func(int) string { return "left" }
+	}
+	out := &bytes.Buffer{}
+	if fences[0] != "" || fences[1] != "" {
+		fmt.Fprintf(out, `<mrow><mo fence="true" stretchy="true">%s</mo>`, fences[0])
+	}
+	out.WriteString("<mtable>")
+	for _, row := range rows {
+		out.WriteString("<mtr>")
+		for i, cell := range row {
+			fmt.Fprintf(out, `<mtd style="text-align:%s">%s</mtd>`, align(i), cell)
+		}
+		out.WriteString("</mtr>")
+	}
+	out.WriteString("</mtable>")
+	if fences[0] != "" || fences[1] != "" {
+		fmt.Fprintf(out, `<mo fence="true" stretchy="true">%s</mo></mrow>`, fences[1])
+	}
+	return out.String(), nil
+}
+
+// styled maps ASCII letters and digits to the Mathematical Alphanumeric
+// Symbols of the current font, since mathvariant is not widely supported.
+func (p *texParser) styled(s string) string {
+	if p.font == "" || p.font == "normal" {
+		return s
+	}
+	out := &strings.Builder{}
+	for _, r := range s {
+		out.WriteRune(mathAlnum(p.font, r))
+	}
+	return out.String()
+}
+
+var mathAlnumBase = map[string][3]rune{
+	// capital A, small a, digit 0; zero when the font has no such range
+	"bold":          {0x1D400, 0x1D41A, 0x1D7CE},
+	"italic":        {0x1D434, 0x1D44E, 0},
+	"bold-italic":   {0x1D468, 0x1D482, 0x1D7CE},
+	"script":        {0x1D49C, 0x1D4B6, 0},
+	"fraktur":       {0x1D504, 0x1D51E, 0},
+	"double-struck": {0x1D538, 0x1D552, 0x1D7D8},
+	"sans-serif":    {0x1D5A0, 0x1D5BA, 0x1D7E2},
+	"monospace":     {0x1D670, 0x1D68A, 0x1D7F6},
+}
+
+// letters that were encoded before the alphanumeric block existed
+var mathAlnumHoles = map[string]map[rune]rune{
+	"italic":        {'h': 'ℎ'},
+	"script":        {'B': 'ℬ', 'E': 'ℰ', 'F': 'ℱ', 'H': 'ℋ', 'I': 'ℐ', 'L': 'ℒ', 'M': 'ℳ', 'R': 'ℛ', 'e': 'ℯ', 'g': 'ℊ', 'o': 'ℴ'},
+	"fraktur":       {'C': 'ℭ', 'H': 'ℌ', 'I': 'ℑ', 'R': 'ℜ', 'Z': 'ℨ'},
+	"double-struck": {'C': 'ℂ', 'H': 'ℍ', 'N': 'ℕ', 'P': 'ℙ', 'Q': 'ℚ', 'R': 'ℝ', 'Z': 'ℤ'},
+}
+
+func mathAlnum(font string, r rune) rune {
+	if h, ok := mathAlnumHoles[font][r]; ok {
+		return h
+	}
+	base := mathAlnumBase[font]
+	switch {
+	case r >= 'A' && r <= 'Z':
+		return base[0] + r - 'A'
+	case r >= 'a' && r <= 'z':
+		return base[1] + r - 'a'
+	case r >= '0' && r <= '9' && base[2] != 0:
+		return base[2] + r - '0'
+	}
+	return r
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
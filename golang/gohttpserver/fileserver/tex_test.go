@@ -0,0 +1,96 @@
+package fileserver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLatexToMathML(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string // the MathML inside the outer <mrow>
+	}{
+		{`x`, `<mi>x</mi>`},
+		{`x_i^2`, `<msubsup><mi>x</mi><mrow><mi>i</mi></mrow><mrow><mn>2</mn></mrow></msubsup>`},
+		{`x''`, `<msup><mi>x</mi><mrow><mo>′</mo><mo>′</mo></mrow></msup>`},
+		{`{{x}}`, `<mrow><mrow><mi>x</mi></mrow></mrow>`},
+		{`\frac{a}{\frac{b}{c}}`, `<mfrac><mrow><mi>a</mi></mrow><mrow><mfrac><mrow><mi>b</mi></mrow><mrow><mi>c</mi></mrow></mfrac></mrow></mfrac>`},
+		{`\sqrt{\sqrt{x}}`, `<msqrt><mrow><msqrt><mrow><mi>x</mi></mrow></msqrt></mrow></msqrt>`},
+		{`\left(\frac{a}{b}\right)`, `<mrow><mo fence="true" stretchy="true">(</mo><mfrac><mrow><mi>a</mi></mrow><mrow><mi>b</mi></mrow></mfrac><mo fence="true" stretchy="true">)</mo></mrow>`},
+		{`\begin{matrix}\begin{pmatrix}a\end{pmatrix}\end{matrix}`, `<mtable><mtr><mtd style="text-align:center"><mrow><mo fence="true" stretchy="true">(</mo><mtable><mtr><mtd style="text-align:center"><mi>a</mi></mtd></mtr></mtable><mo fence="true" stretchy="true">)</mo></mrow></mtd></mtr></mtable>`},
+		{`\sin x`, `<mi>sin</mi><mspace width="0.1667em"></mspace><mi>x</mi>`},
+		{`a<b`, `<mi>a</mi><mo>&lt;</mo><mi>b</mi>`},
+		{`a\not=b`, `<mi>a</mi><mo>≠</mo><mi>b</mi>`},
+		{`\text{<b>}`, `<mtext>&lt;b&gt;</mtext>`},
+	}
+	for _, tt := range tests {
+		got, err := latexToMathML(tt.src, false)
+		if err != nil {
+			t.Errorf("latexToMathML(%q): %v", tt.src, err)
+			continue
+		}
+		if want := "<mrow>" + tt.want + "</mrow><annotation"; !strings.Contains(got, want) {
+			t.Errorf("latexToMathML(%q) = %s, want it to hold %s", tt.src, got, want)
+		}
+	}
+}
+
+func TestLatexToMathMLAnnotation(t *testing.T) {
+	got, err := latexToMathML(`a<b`, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(got, `display="block"`) || !strings.Contains(got, `encoding="application/x-tex">a&lt;b</annotation>`) {
+		t.Errorf("latexToMathML(a<b, display) = %s", got)
+	}
+}
+
+func TestLatexToMathMLErrors(t *testing.T) {
+	tests := []struct {
+		src string
+		err string
+	}{
+		{`{x`, `missing "}"`},
+		{`x}`, `unexpected "}"`},
+		{`\frac{a}`, `missing argument`},
+		{`x\not`, `missing argument`},
+		{`\not}`, `missing argument before "}"`},
+		{`\frac{a}{b`, `missing "}"`},
+		{`\text{a`, `missing "}"`},
+		{`x^`, `missing argument`},
+		{`x^2^3`, `double superscript`},
+		{`\unknowncmd`, `unknown command \unknowncmd`},
+		{`\`, `unknown command \`},
+		{`\left(x`, `missing "\\right"`},
+		{`\left(x\right`, `missing delimiter`},
+		{`\left\foo x\right)`, `bad delimiter "\\foo"`},
+		{`\right)`, `\right without \left`},
+		{`\begin{matrix}a`, `missing "\\end"`},
+		{`\begin{matrix}a\end{pmatrix}`, `\begin{matrix} ended by \end{pmatrix}`},
+		{`\begin{foo}a\end{foo}`, `unknown environment "foo"`},
+		{`\end{matrix}`, `\end without \begin`},
+		{strings.Repeat("{", 64) + "x" + strings.Repeat("}", 64), `nested too deeply`},
+		{strings.Repeat(`\sqrt{`, 70) + "x" + strings.Repeat("}", 70), `nested too deeply`},
+		{strings.Repeat("{", 100000), `nested too deeply`},
+	}
+	for _, tt := range tests {
+		src := tt.src
+		if len(src) > 40 {
+			src = src[:40] + "..."
+		}
+		got, err := latexToMathML(tt.src, false)
+		if err == nil {
+			t.Errorf("latexToMathML(%q) = %s, want error %q", src, got, tt.err)
+		} else if err.Error() != tt.err {
+			t.Errorf("latexToMathML(%q) failed with %q, want %q", src, err, tt.err)
+		}
+	}
+}
+
+func TestLatexToMathMLDepth(t *testing.T) {
+	// nesting within the limit renders
+	src := strings.Repeat("{", 63) + "x" + strings.Repeat("}", 63)
+	if _, err := latexToMathML(src, false); err != nil {
+		t.Errorf("63 nested groups: %v", err)
+	}
+}
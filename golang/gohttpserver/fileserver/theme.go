@@ -0,0 +1,157 @@
+package fileserver
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"strings"
+)
+
+var themeNames = []string{"light", "dark", "auto"}
+
+// lightColors and darkColors set the variables themeCss is written in.
+const lightColors = `  color-scheme: light;
+  --bg: #fff;
+  --fg: #333;
+  --muted: #777;
+  --link: #4078c0;
+  --border: #ddd;
+  --border-light: #eee;
+  --code-bg: #f7f7f7;
+  --stripe: #f8f8f8;
+`
+
+const darkColors = `  color-scheme: dark;
+  --bg: #0d1117;
+  --fg: #c9d1d9;
+  --muted: #8b949e;
+  --link: #58a6ff;
+  --border: #30363d;
+  --border-light: #21262d;
+  --code-bg: #161b22;
+  --stripe: #161b22;
+`
+
+const themeCss = `
+body {
+  background-color: var(--bg);
+  color: var(--fg);
+}
+
+article, articleMeta, .readme {
+  border-color: var(--border);
+}
+
+articleMeta a, .markdown-body h6, .markdown-body blockquote, .comment .comment-meta {
+  color: var(--muted);
+}
+
+.markdown-body, .markdown-body h1 .octicon-link, .markdown-body h2 .octicon-link, .markdown-body h3 .octicon-link,
+.markdown-body h4 .octicon-link, .markdown-body h5 .octicon-link, .markdown-body h6 .octicon-link {
+  color: var(--fg);
+}
+
+.markdown-body a, a.icon {
+  color: var(--link);
+}
+
+.markdown-body h1, .markdown-body h2, #header, .comment h3, .comment .comment-item {
+  border-bottom-color: var(--border-light);
+}
+
+.markdown-body hr {
+  background-color: var(--border);
+}
+
+.markdown-body blockquote {
+  border-left-color: var(--border);
+}
+
+.markdown-body table th, .markdown-body table td {
+  border-color: var(--border);
+}
+
+.markdown-body table tr {
+  background-color: var(--bg);
+  border-top-color: var(--border);
+}
+
+.markdown-body table tr:nth-child(2n), #tbody tr:nth-child(2n) {
+  background-color: var(--stripe);
+}
+
+.markdown-body code, .markdown-body tt, .markdown-body pre, .markdown-body .highlight pre {
+  background-color: var(--code-bg);
+}
+
+.markdown-body kbd {
+  color: var(--fg);
+  background-color: var(--code-bg);
+  border-color: var(--border);
+}
+
+.comment input, .comment textarea, .comment button {
+  color: var(--fg);
+  background-color: var(--bg);
+  border: 1px solid var(--border);
+}
+
+/* listings in the font and spacing of markdown tables */
+#header, #theader, #tbody {
+  font-family: "Helvetica Neue", Helvetica, "Segoe UI", Arial, freesans, sans-serif;
+}
+
+#theader th {
+  text-align: left;
+  padding: 6px 13px;
+  border-bottom: 1px solid var(--border);
+}
+
+#tbody td {
+  padding: 4px 13px;
+}
+`
+
+// initThemes adds the theme stylesheets to the server's css. It runs after
+// initMarkdown, whose highlight style the light theme keeps.
+func (s *Server) initThemes() error {
+	dark, err := highlightCSS(s.highlightDark)
+	if err != nil {
+		return err
+	}
+	s.css["/theme-light.css"] = ":root {\n" + lightColors + "}\n" + themeCss
+	s.css["/theme-dark.css"] = ":root {\n" + darkColors + "}\n" + themeCss + dark
+	s.css["/theme-auto.css"] = ":root {\n" + lightColors + "}\n" + themeCss +
+		"\n@media (prefers-color-scheme: dark) {\n:root {\n" + darkColors + "}\n" + dark + "}\n"
+	if !validTheme(s.theme) {
+		return fmt.Errorf("unknown theme %q, available: %s", s.theme, strings.Join(themeNames, ", "))
+	}
+	return nil
+}
+
+func validTheme(name string) bool {
+	for _, n := range themeNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// themeLinks is the <head> HTML selecting the theme of pages in dir.
+func (s *Server) themeLinks(dir string) string {
+	cfg := s.dirConfigFor(dir)
+	theme := s.theme
+	if cfg.Theme != "" {
+		if validTheme(cfg.Theme) {
+			theme = cfg.Theme
+		} else {
+			log.Printf("%s: unknown theme %q", dir, cfg.Theme)
+		}
+	}
+	links := fmt.Sprintf(`<link rel="stylesheet" type="text/css" href="/theme-%s.css"/>`, theme)
+	if cfg.CSS != "" {
+		links += fmt.Sprintf("\n"+`<link rel="stylesheet" type="text/css" href="%s"/>`, template.HTMLEscapeString(cfg.CSS))
+	}
+	return links
+}
@@ -0,0 +1,89 @@
+package fileserver
+
+import (
+	"log"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// dirWatcher fans filesystem notifications for watched directories out to
+// every subscriber. It is started lazily and degrades to a no-op when the
+// platform refuses to give us a watcher.
+type dirWatcher struct {
+	mu        sync.Mutex
+	w         *fsnotify.Watcher
+	failed    bool
+	dirs      map[string]bool
+	listeners map[int]func(name string)
+	nextID    int
+}
+
+var watcher = &dirWatcher{
+	dirs:      map[string]bool{},
+	listeners: map[int]func(string){},
+}
+
+func (dw *dirWatcher) add(dir string) {
+	dw.mu.Lock()
+	defer dw.mu.Unlock()
+	if dw.dirs[dir] || dw.failed {
+		return
+	}
+	if dw.w == nil {
+		w, err := fsnotify.NewWatcher()
+		if err != nil {
+			log.Println("watcher disabled:", err)
+			dw.failed = true
+			return
+		}
+		dw.w = w
+		go dw.loop()
+	}
+	if err := dw.w.Add(dir); err != nil {
+		log.Println("watch", dir, err)
+		return
+	}
+	dw.dirs[dir] = true
+}
+
+func (dw *dirWatcher) subscribe(fn func(name string)) (cancel func()) {
+	dw.mu.Lock()
+	defer dw.mu.Unlock()
+	id := dw.nextID
+	dw.nextID++
+	dw.listeners[id] = fn
+	return func() {
+		dw.mu.Lock()
+		delete(dw.listeners, id)
+		dw.mu.Unlock()
+	}
+}
+
+func (dw *dirWatcher) loop() {
+	for {
+		select {
+		case ev, ok := <-dw.w.Events:
+			if !ok {
+				return
+			}
+			dw.mu.Lock()
+			if ev.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				delete(dw.dirs, ev.Name)
+			}
+			fns := make([]func(string), 0, len(dw.listeners))
+			for _, fn := range dw.listeners {
+				fns = append(fns, fn)
+			}
+			dw.mu.Unlock()
+			for _, fn := range fns {
+				fn(ev.Name)
+			}
+		case err, ok := <-dw.w.Errors:
+			if !ok {
+				return
+			}
+			log.Println("watcher:", err)
+		}
+	}
+}